@@ -0,0 +1,51 @@
+package vncalendar
+
+// The twelve spirits (thập nhị thần) rotate over the branches,
+// six of them are auspicious (hoàng đạo):
+// Thanh Long, Minh Đường, Kim Quỹ, Kim Đường, Ngọc Đường and Tư Mệnh
+var hoangDaoSpirits = [12]bool{
+	true,  // Thanh Long
+	true,  // Minh Đường
+	false, // Thiên Hình
+	false, // Chu Tước
+	true,  // Kim Quỹ
+	true,  // Kim Đường
+	false, // Bạch Hổ
+	true,  // Ngọc Đường
+	false, // Thiên Lao
+	false, // Huyền Vũ
+	true,  // Tư Mệnh
+	false, // Câu Trần
+}
+
+// isHoangDao reports whether branch c is auspicious under the ruling branch.
+// Thanh Long starts at Thân for Tý/Ngọ, at Tuất for Sửu/Mùi and so on,
+// the same rule gives auspicious days of a month and hours of a day.
+func isHoangDao(ruling, c Chi) bool {
+	thanhLong := mod(int(ruling)%6*2+8, 12)
+	return hoangDaoSpirits[mod(int(c)-thanhLong, 12)]
+}
+
+// IsHoangDao reports whether the date is an auspicious day (ngày hoàng đạo)
+func (t VNDate) IsHoangDao() bool {
+	return isHoangDao(t.MonthCanChi().Chi, t.DayCanChi().Chi)
+}
+
+// Lunar days of ngày Tam Nương
+var tamNuongDays = []int{3, 7, 13, 18, 22, 27}
+
+// IsTamNuong reports whether the date is ngày Tam Nương
+func (t VNDate) IsTamNuong() bool {
+	for _, d := range tamNuongDays {
+		if t.Day() == d {
+			return true
+		}
+	}
+	return false
+}
+
+// ClashesWithYear reports whether the day branch is in opposition (xung)
+// to the branch of the given lunar year, typically a birth year
+func (t VNDate) ClashesWithYear(lunarYear int) bool {
+	return t.DayCanChi().Chi.Clashes(YearCanChi(lunarYear).Chi)
+}
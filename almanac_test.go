@@ -0,0 +1,30 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsHoangDao(t *testing.T) {
+	// Giáp Thìn, Kim Quỹ
+	assert.True(t, Date(2024, time.February, 10, 12, 0, 0, 0).IsHoangDao())
+	// Ất Tỵ, Kim Đường
+	assert.True(t, Date(2024, time.February, 11, 12, 0, 0, 0).IsHoangDao())
+	// Bính Ngọ, Bạch Hổ
+	assert.False(t, Date(2024, time.February, 12, 12, 0, 0, 0).IsHoangDao())
+}
+
+func TestIsTamNuong(t *testing.T) {
+	// mùng 3 Tết Giáp Thìn
+	assert.True(t, Date(2024, time.February, 12, 12, 0, 0, 0).IsTamNuong())
+	assert.False(t, Date(2024, time.February, 13, 12, 0, 0, 0).IsTamNuong())
+}
+
+func TestClashesWithYear(t *testing.T) {
+	// Bính Ngọ day
+	d := Date(2024, time.February, 12, 12, 0, 0, 0)
+	assert.True(t, d.ClashesWithYear(1984))
+	assert.False(t, d.ClashesWithYear(1990))
+}
@@ -0,0 +1,297 @@
+// Package api serves calendar conversions as JSON over HTTP.
+// The handler can be mounted in any server, use http.StripPrefix
+// to serve it under a path. openapi.json describes the endpoints
+package api
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+)
+
+//go:embed openapi.json
+var openAPI []byte
+
+// Handler serves the API
+type Handler struct {
+	mux *http.ServeMux
+	// now gives the DTSTAMP and default year of ical feeds, replaced in tests
+	now func() time.Time
+}
+
+// NewHandler returns the API handler with all endpoints
+func NewHandler() *Handler {
+	h := &Handler{mux: http.NewServeMux(), now: time.Now}
+	h.mux.HandleFunc("GET /solar2lunar", h.solar2lunar)
+	h.mux.HandleFunc("GET /lunar2solar", h.lunar2solar)
+	h.mux.HandleFunc("GET /months/{year}/{month}", h.month)
+	h.mux.HandleFunc("GET /lunar-years/{year}", h.lunarYear)
+	h.mux.HandleFunc("GET /holidays/{year}", h.holidays)
+	h.mux.HandleFunc("GET /ical/holidays.ics", h.holidaysICal)
+	h.mux.HandleFunc("GET /ical/moon-days.ics", h.moonDaysICal)
+	h.mux.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(openAPI)
+	})
+	h.mux.HandleFunc("GET /", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not_found", "no endpoint "+r.URL.Path)
+	})
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// LunarDate is a lunar date in responses
+type LunarDate struct {
+	Year  int  `json:"year"`
+	Month int  `json:"month"`
+	Day   int  `json:"day"`
+	Leap  bool `json:"leap"`
+}
+
+// CanChi holds the sexagenary names of the year, month and day
+type CanChi struct {
+	Year  string `json:"year"`
+	Month string `json:"month"`
+	Day   string `json:"day"`
+}
+
+// Day is a solar date with its lunar date
+type Day struct {
+	Solar     string    `json:"solar"`
+	Weekday   string    `json:"weekday"`
+	Lunar     LunarDate `json:"lunar"`
+	CanChi    CanChi    `json:"can_chi"`
+	SolarTerm string    `json:"solar_term"`
+	Holidays  []string  `json:"holidays,omitempty"`
+}
+
+func newDay(d vncalendar.VNDate) Day {
+	lunar := d.LunarDate()
+	day := Day{
+		Solar:   d.SolarTime().Format(time.DateOnly),
+		Weekday: d.SolarTime().Weekday().String(),
+		Lunar:   LunarDate{Year: lunar.Year, Month: lunar.Month, Day: lunar.Day, Leap: lunar.Leap},
+		CanChi: CanChi{
+			Year:  d.YearCanChi().String(),
+			Month: d.MonthCanChi().String(),
+			Day:   d.DayCanChi().String(),
+		},
+		SolarTerm: d.SolarTerm().String(),
+	}
+	for _, h := range d.Holidays() {
+		day.Holidays = append(day.Holidays, h.Name)
+	}
+	return day
+}
+
+// Month is a solar month grid, weeks start on Monday unless
+// week_start=sunday, days outside the month are null
+type Month struct {
+	Year  int      `json:"year"`
+	Month int      `json:"month"`
+	Weeks [][]*Day `json:"weeks"`
+}
+
+// LunarYear describes a lunar year from Tết to the day before the next Tết
+type LunarYear struct {
+	Year   int    `json:"year"`
+	CanChi string `json:"can_chi"`
+	Start  string `json:"start"`
+	End    string `json:"end"`
+	Days   int    `json:"days"`
+	// LeapMonth is 0 in a year without leap month
+	LeapMonth int          `json:"leap_month"`
+	Months    []LunarMonth `json:"months"`
+}
+
+// LunarMonth is a month of a lunar year
+type LunarMonth struct {
+	Month  int    `json:"month"`
+	Leap   bool   `json:"leap"`
+	CanChi string `json:"can_chi"`
+	Start  string `json:"start"`
+	Days   int    `json:"days"`
+}
+
+// Holiday is a holiday on a date
+type Holiday struct {
+	Name   string `json:"name"`
+	Lunar  bool   `json:"lunar"`
+	Public bool   `json:"public"`
+	Date   Day    `json:"date"`
+}
+
+// Error is the body of error responses
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func (h *Handler) solar2lunar(w http.ResponseWriter, r *http.Request) {
+	d, err := vncalendar.ParseSolarDate(r.URL.Query().Get("date"))
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	writeJSON(w, newDay(d))
+}
+
+func (h *Handler) lunar2solar(w http.ResponseWriter, r *http.Request) {
+	leap, err := parseBool(r.URL.Query().Get("leap"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_leap", err.Error())
+		return
+	}
+	d, err := vncalendar.ParseLunarDate(r.URL.Query().Get("date"), leap)
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	writeJSON(w, newDay(vncalendar.FromSolarDay(d.SolarTime())))
+}
+
+func (h *Handler) month(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("year"))
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	month, err := strconv.Atoi(r.PathValue("month"))
+	if err != nil || month < 1 || month > 12 {
+		writeDateError(w, vncalendar.ErrInvalidMonth)
+		return
+	}
+	weekStart := time.Monday
+	switch r.URL.Query().Get("week_start") {
+	case "", "monday":
+	case "sunday":
+		weekStart = time.Sunday
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_week_start", "week_start is monday or sunday")
+		return
+	}
+	dates := vncalendar.GetMonthDates(year, time.Month(month))
+	result := Month{Year: year, Month: month}
+	for _, days := range vncalendar.MonthGrid(dates, weekStart) {
+		week := make([]*Day, len(days))
+		for i, d := range days {
+			if d != nil {
+				day := newDay(*d)
+				week[i] = &day
+			}
+		}
+		result.Weeks = append(result.Weeks, week)
+	}
+	writeJSON(w, result)
+}
+
+func (h *Handler) lunarYear(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("year"))
+	if err == nil && year == vncalendar.MaxSupportedYear {
+		// the end is the day before Tết of the next year
+		err = &vncalendar.RangeError{Year: year}
+	}
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	result := LunarYear{Year: year, CanChi: vncalendar.YearCanChi(year).String()}
+	var starts []vncalendar.VNDate
+	for month := 1; month <= 12; month++ {
+		for _, leap := range []bool{false, true} {
+			d, err := vncalendar.ParseLunarDate(fmt.Sprintf("%04d-%02d-01", year, month), leap)
+			if err != nil {
+				continue
+			}
+			if leap {
+				result.LeapMonth = month
+			}
+			starts = append(starts, d)
+		}
+	}
+	next, _ := vncalendar.ParseDate(fmt.Sprintf("%04d-01-01", year+1))
+	starts = append(starts, next)
+	for i, d := range starts[:len(starts)-1] {
+		result.Months = append(result.Months, LunarMonth{
+			Month:  d.LunarDate().Month,
+			Leap:   d.LunarDate().Leap,
+			CanChi: d.MonthCanChi().String(),
+			Start:  d.SolarTime().Format(time.DateOnly),
+			Days:   starts[i+1].JDN() - d.JDN(),
+		})
+	}
+	result.Start = starts[0].SolarTime().Format(time.DateOnly)
+	result.End = next.PreviousDay().SolarTime().Format(time.DateOnly)
+	result.Days = next.JDN() - starts[0].JDN()
+	writeJSON(w, result)
+}
+
+func (h *Handler) holidays(w http.ResponseWriter, r *http.Request) {
+	year, err := parseYear(r.PathValue("year"))
+	if err != nil {
+		writeDateError(w, err)
+		return
+	}
+	holidays := []Holiday{}
+	for _, hd := range vncalendar.HolidaysIn(year) {
+		holidays = append(holidays, Holiday{Name: hd.Name, Lunar: hd.Lunar, Public: hd.Public, Date: newDay(vncalendar.FromSolarDay(hd.Date.SolarTime()))})
+	}
+	writeJSON(w, holidays)
+}
+
+func parseYear(s string) (int, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, vncalendar.ErrInvalidDateFormat
+	}
+	if year < vncalendar.MinSupportedYear || year > vncalendar.MaxSupportedYear {
+		return 0, &vncalendar.RangeError{Year: year}
+	}
+	return year, nil
+}
+
+func parseBool(s string) (bool, error) {
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// writeDateError maps the errors of ParseDate to error codes
+func writeDateError(w http.ResponseWriter, err error) {
+	var rangeErr *vncalendar.RangeError
+	code := "invalid_date"
+	switch {
+	case errors.As(err, &rangeErr):
+		code = "year_out_of_range"
+	case errors.Is(err, vncalendar.ErrInvalidDateFormat):
+		code = "invalid_date_format"
+	case errors.Is(err, vncalendar.ErrInvalidMonth):
+		code = "invalid_month"
+	case errors.Is(err, vncalendar.ErrInvalidDay):
+		code = "invalid_day"
+	}
+	writeError(w, http.StatusBadRequest, code, err.Error())
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Error Error `json:"error"`
+	}{Error{Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, h http.Handler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+func getJSON(t *testing.T, h http.Handler, target string, v any) {
+	t.Helper()
+	rec := get(t, h, target)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), v))
+}
+
+func TestSolar2Lunar(t *testing.T) {
+	var day Day
+	getJSON(t, NewHandler(), "/solar2lunar?date=2025-01-29", &day)
+	assert.Equal(t, "2025-01-29", day.Solar)
+	assert.Equal(t, "Wednesday", day.Weekday)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 1, Day: 1}, day.Lunar)
+	assert.Equal(t, "Ất Tỵ", day.CanChi.Year)
+	assert.Equal(t, "Mậu Tuất", day.CanChi.Day)
+	assert.Contains(t, day.Holidays, "Tết Nguyên Đán")
+}
+
+func TestLunar2Solar(t *testing.T) {
+	h := NewHandler()
+	var day Day
+	getJSON(t, h, "/lunar2solar?date=2025-06-15&leap=true", &day)
+	assert.Equal(t, "2025-08-08", day.Solar)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 6, Day: 15, Leap: true}, day.Lunar)
+
+	getJSON(t, h, "/lunar2solar?date=2025-06-15", &day)
+	assert.Equal(t, "2025-07-09", day.Solar)
+	assert.False(t, day.Lunar.Leap)
+}
+
+func TestValidation(t *testing.T) {
+	h := NewHandler()
+	for target, code := range map[string]string{
+		"/solar2lunar?date=2025/01/29":          "invalid_date_format",
+		"/solar2lunar":                          "invalid_date_format",
+		"/solar2lunar?date=2025-13-01":          "invalid_month",
+		"/solar2lunar?date=2025-02-30":          "invalid_day",
+		"/solar2lunar?date=0999-01-01":          "year_out_of_range",
+		"/lunar2solar?date=2025-13-01":          "invalid_month",
+		"/lunar2solar?date=2025-01-32":          "invalid_day",
+		"/lunar2solar?date=2025-02-30":          "invalid_date",
+		"/lunar2solar?date=2024-04-01&leap=1":   "invalid_date",
+		"/lunar2solar?date=2025-06-15&leap=ok":  "invalid_leap",
+		"/months/2025/13":                       "invalid_month",
+		"/months/3001/1":                        "year_out_of_range",
+		"/months/2025/1?week_start=friday":      "invalid_week_start",
+		"/lunar-years/abc":                      "invalid_date_format",
+		"/lunar-years/3000":                     "year_out_of_range",
+		"/holidays/999":                         "year_out_of_range",
+		"/ical/holidays.ics?years=11":           "invalid_years",
+		"/ical/moon-days.ics?year=2999&years=3": "year_out_of_range",
+		"/unknown":                              "not_found",
+	} {
+		rec := get(t, h, target)
+		var body struct{ Error Error }
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body), target)
+		assert.Equal(t, code, body.Error.Code, target)
+		assert.NotEmpty(t, body.Error.Message, target)
+		if code == "not_found" {
+			assert.Equal(t, http.StatusNotFound, rec.Code, target)
+		} else {
+			assert.Equal(t, http.StatusBadRequest, rec.Code, target)
+		}
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/solar2lunar?date=2025-01-29", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestMonth(t *testing.T) {
+	h := NewHandler()
+	var month Month
+	getJSON(t, h, "/months/2025/1", &month)
+	assert.Equal(t, 2025, month.Year)
+	assert.Equal(t, 1, month.Month)
+	// 1 January 2025 is a Wednesday
+	require.Len(t, month.Weeks, 5)
+	assert.Nil(t, month.Weeks[0][0])
+	assert.Nil(t, month.Weeks[0][1])
+	assert.Equal(t, "2025-01-01", month.Weeks[0][2].Solar)
+	assert.Equal(t, "2025-01-29", month.Weeks[4][2].Solar)
+	assert.Nil(t, month.Weeks[4][6])
+	for _, week := range month.Weeks {
+		assert.Len(t, week, 7)
+	}
+
+	getJSON(t, h, "/months/2025/1?week_start=sunday", &month)
+	assert.Nil(t, month.Weeks[0][2])
+	assert.Equal(t, "2025-01-01", month.Weeks[0][3].Solar)
+	assert.Equal(t, "Wednesday", month.Weeks[0][3].Weekday)
+}
+
+func TestLunarYear(t *testing.T) {
+	var year LunarYear
+	getJSON(t, NewHandler(), "/lunar-years/2025", &year)
+	assert.Equal(t, "Ất Tỵ", year.CanChi)
+	assert.Equal(t, "2025-01-29", year.Start)
+	assert.Equal(t, "2026-02-16", year.End)
+	assert.Equal(t, 384, year.Days)
+	assert.Equal(t, 6, year.LeapMonth)
+	require.Len(t, year.Months, 13)
+	assert.Equal(t, LunarMonth{Month: 6, Leap: true, CanChi: year.Months[6].CanChi, Start: "2025-07-25", Days: year.Months[6].Days}, year.Months[6])
+	days := 0
+	for _, m := range year.Months {
+		assert.Contains(t, []int{29, 30}, m.Days)
+		days += m.Days
+	}
+	assert.Equal(t, year.Days, days)
+}
+
+func TestHolidays(t *testing.T) {
+	var holidays []Holiday
+	getJSON(t, NewHandler(), "/holidays/2025", &holidays)
+	require.NotEmpty(t, holidays)
+	assert.Equal(t, "Tết Dương lịch", holidays[0].Name)
+	assert.Equal(t, "2025-01-01", holidays[0].Date.Solar)
+	found := false
+	for _, h := range holidays {
+		if h.Name == "Tết Nguyên Đán" {
+			found = true
+			assert.True(t, h.Lunar)
+			assert.True(t, h.Public)
+			assert.Equal(t, "2025-01-29", h.Date.Solar)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestICal(t *testing.T) {
+	h := NewHandler()
+	h.now = func() time.Time { return time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC) }
+
+	rec := get(t, h, "/ical/holidays.ics")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/calendar; charset=utf-8", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	assert.Contains(t, body, "DTSTAMP:20250301T100000Z\r\n")
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:20250129\r\nDTEND;VALUE=DATE:20250130\r\nSUMMARY:Tết Nguyên Đán\r\n")
+	assert.NotContains(t, body, "DTSTART;VALUE=DATE:2026")
+	assert.Equal(t, strings.Count(body, "BEGIN:VEVENT"), strings.Count(body, "UID:holiday-"))
+	assert.Contains(t, body, "UID:holiday-lunar-2025-01-01@vncalendar\r\nDTSTAMP:20250301T100000Z\r\nDTSTART;VALUE=DATE:20250129\r\n")
+	assert.Contains(t, body, "UID:holiday-solar-2025-01-01@vncalendar\r\n")
+
+	// UIDs are unique across years
+	body = get(t, h, "/ical/holidays.ics?year=2024&years=10").Body.String()
+	uids := make(map[string]bool)
+	for _, line := range strings.Split(body, "\r\n") {
+		if strings.HasPrefix(line, "UID:") {
+			assert.False(t, uids[line], line)
+			uids[line] = true
+		}
+	}
+	assert.Equal(t, strings.Count(body, "BEGIN:VEVENT"), len(uids))
+
+	rec = get(t, h, "/ical/moon-days.ics?year=2025&years=2")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body = rec.Body.String()
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:20250129\r\nDTEND;VALUE=DATE:20250130\r\nSUMMARY:Mùng 1 tháng 1\r\n")
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:20250808\r\nDTEND;VALUE=DATE:20250809\r\nSUMMARY:Rằm tháng 6 nhuận\r\n")
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:2026")
+	// about 25 lunar months in two years, two days each
+	events := strings.Count(body, "BEGIN:VEVENT")
+	assert.True(t, events >= 48 && events <= 52, events)
+}
+
+func TestICalText(t *testing.T) {
+	assert.Equal(t, `a\, b\; c\\d\ne`, escapeText("a, b; c\\d\ne"))
+
+	line := "SUMMARY:" + strings.Repeat("ạ", 40)
+	folded := foldLine(line)
+	parts := strings.Split(folded, "\r\n")
+	require.Len(t, parts, 2)
+	assert.LessOrEqual(t, len(parts[0]), 75)
+	assert.True(t, strings.HasPrefix(parts[1], " "))
+	assert.Equal(t, line, parts[0]+parts[1][1:])
+	assert.Equal(t, "short", foldLine("short"))
+}
+
+func TestOpenAPI(t *testing.T) {
+	var doc struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	getJSON(t, NewHandler(), "/openapi.json", &doc)
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+	for _, path := range []string{"/solar2lunar", "/lunar2solar", "/months/{year}/{month}", "/lunar-years/{year}", "/holidays/{year}", "/ical/holidays.ics", "/ical/moon-days.ics", "/openapi.json"} {
+		assert.Contains(t, doc.Paths, path)
+		assert.Contains(t, doc.Paths[path], "get", path)
+	}
+}
+
+func TestStripPrefix(t *testing.T) {
+	var day Day
+	getJSON(t, http.StripPrefix("/calendar", NewHandler()), "/calendar/solar2lunar?date=2025-01-29", &day)
+	assert.Equal(t, 1, day.Lunar.Day)
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/vanng822/vncalendar"
+)
+
+// maxFeedYears limits the years of one ical feed
+const maxFeedYears = 10
+
+// icalEvent is an all day event
+type icalEvent struct {
+	uid     string
+	summary string
+	date    time.Time
+}
+
+func (h *Handler) holidaysICal(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := h.feedYears(w, r)
+	if !ok {
+		return
+	}
+	var events []icalEvent
+	for year := from; year <= to; year++ {
+		for _, hd := range vncalendar.HolidaysIn(year) {
+			events = append(events, icalEvent{
+				uid:     holidayUID(hd),
+				summary: hd.Name,
+				date:    hd.Date.SolarTime(),
+			})
+		}
+	}
+	h.writeICal(w, "Ngày lễ Việt Nam", events)
+}
+
+// holidayUID identifies a holiday in a year by its own date, so that
+// changes to vncalendar.Holidays keep the other UIDs
+func holidayUID(hd vncalendar.HolidayDate) string {
+	if hd.Lunar {
+		return fmt.Sprintf("holiday-lunar-%04d-%02d-%02d@vncalendar", hd.Date.LunarDate().Year, hd.Month, hd.Day)
+	}
+	return fmt.Sprintf("holiday-solar-%04d-%02d-%02d@vncalendar", hd.Date.SolarTime().Year(), hd.Month, hd.Day)
+}
+
+func (h *Handler) moonDaysICal(w http.ResponseWriter, r *http.Request) {
+	from, to, ok := h.feedYears(w, r)
+	if !ok {
+		return
+	}
+	var events []icalEvent
+	for year := from; year <= to; year++ {
+		for month := time.January; month <= time.December; month++ {
+			for _, d := range vncalendar.GetMonthDates(year, month) {
+				lunar := d.LunarDate()
+				if lunar.Day != 1 && lunar.Day != 15 {
+					continue
+				}
+				date := d.SolarTime()
+				events = append(events, icalEvent{
+					uid:     fmt.Sprintf("moon-%s@vncalendar", date.Format("20060102")),
+					summary: moonDayName(lunar),
+					date:    date,
+				})
+			}
+		}
+	}
+	h.writeICal(w, "Mùng 1 và rằm", events)
+}
+
+func moonDayName(d vncalendar.LunarDate) string {
+	name := "Mùng 1"
+	if d.Day == 15 {
+		name = "Rằm"
+	}
+	name += " tháng " + strconv.Itoa(d.Month)
+	if d.Leap {
+		name += " nhuận"
+	}
+	return name
+}
+
+// feedYears reads year and years, the feed covers years solar years from year.
+// year defaults to the current year in Vietnam
+func (h *Handler) feedYears(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	query := r.URL.Query()
+	year := h.now().In(vncalendar.VietNamTimeZone).Year()
+	if s := query.Get("year"); s != "" {
+		var err error
+		if year, err = parseYear(s); err != nil {
+			writeDateError(w, err)
+			return 0, 0, false
+		}
+	}
+	years := 1
+	if s := query.Get("years"); s != "" {
+		var err error
+		years, err = strconv.Atoi(s)
+		if err != nil || years < 1 || years > maxFeedYears {
+			writeError(w, http.StatusBadRequest, "invalid_years", fmt.Sprintf("years is 1-%d", maxFeedYears))
+			return 0, 0, false
+		}
+	}
+	to := year + years - 1
+	if to > vncalendar.MaxSupportedYear {
+		writeDateError(w, &vncalendar.RangeError{Year: to})
+		return 0, 0, false
+	}
+	return year, to, true
+}
+
+func (h *Handler) writeICal(w http.ResponseWriter, name string, events []icalEvent) {
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	writeICal(w, name, events, h.now())
+}
+
+// writeICal writes an RFC 5545 calendar
+func writeICal(w io.Writer, name string, events []icalEvent, stamp time.Time) {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//vncalendar//api//VI",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:" + escapeText(name),
+		"X-WR-TIMEZONE:Asia/Ho_Chi_Minh",
+	}
+	dtstamp := stamp.UTC().Format("20060102T150405Z")
+	for _, e := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+e.uid,
+			"DTSTAMP:"+dtstamp,
+			"DTSTART;VALUE=DATE:"+e.date.Format("20060102"),
+			"DTEND;VALUE=DATE:"+e.date.AddDate(0, 0, 1).Format("20060102"),
+			"SUMMARY:"+escapeText(e.summary),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+	for _, line := range lines {
+		io.WriteString(w, foldLine(line)+"\r\n")
+	}
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// foldLine splits lines longer than 75 octets without breaking a UTF-8 character
+func foldLine(line string) string {
+	const limit = 75
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			// the leading space counts
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,196 @@
+package vncalendar
+
+import (
+	"time"
+
+	"github.com/vanng822/vncalendar/deltat"
+)
+
+// Calendar creates VNDate with its own settings,
+// package level functions such as Date and Today use the defaults
+type Calendar struct {
+	// location is nil until NewCalendar falls back to VietNamTimeZone
+	location *time.Location
+	// calculation is the zone of WithCalculationZone, nil for location
+	calculation  *time.Location
+	zone         zone
+	dayStartHour int
+	engine       Engine
+	deltaT       deltat.Model
+	reform       Reform
+}
+
+type CalendarOption func(c *Calendar)
+
+// WithDayStartHour sets the hour of the previous solar day when a new day starts.
+// Default is 0, midnight. Some traditions start the day at giờ Tý, 23:00,
+// so a time from 23:00 belongs to the next day for lunar date and Can-Chi.
+// The hour is 0-23, other values are ignored and the day starts at midnight
+func WithDayStartHour(hour int) CalendarOption {
+	return func(c *Calendar) {
+		if hour < 0 || hour > 23 {
+			return
+		}
+		c.dayStartHour = hour
+	}
+}
+
+// WithEngine selects the astronomical algorithms, default is StandardEngine
+func WithEngine(engine Engine) CalendarOption {
+	return func(c *Calendar) {
+		c.engine = engine
+	}
+}
+
+// WithLocation sets the time zone of dates, and of the conversion
+// unless WithCalculationZone is given. Its UTC offset is evaluated at each
+// new moon and solar term, so a location of time.LoadLocation follows its
+// history. nil is VietNamTimeZone
+func WithLocation(loc *time.Location) CalendarOption {
+	return func(c *Calendar) {
+		c.location = loc
+	}
+}
+
+// WithCalculationZone sets the time zone the lunar calendar is computed for,
+// dates are still in the zone of WithLocation. Its UTC offset is evaluated
+// at each new moon and solar term, so Hanoi or Saigon gives the calendar
+// in use at the time. nil is the zone of WithLocation
+func WithCalculationZone(loc *time.Location) CalendarOption {
+	return func(c *Calendar) {
+		c.calculation = loc
+	}
+}
+
+// WithReform sets the Gregorian reform of SolarDate in Solar2lunar and
+// Lunar2solar, default is Reform1582. VNDate is always proleptic Gregorian
+// as time.Time, its JulianDate gives the date in the Julian calendar
+func WithReform(reform Reform) CalendarOption {
+	return func(c *Calendar) {
+		c.reform = reform
+	}
+}
+
+// WithDeltaT sets the ΔT model used for new moons and solar terms,
+// default is deltat.EspenakMeeus. A deltat.Table of observed values
+// can be given for better accuracy in a known period
+func WithDeltaT(model deltat.Model) CalendarOption {
+	return func(c *Calendar) {
+		c.deltaT = model
+	}
+}
+
+// NewCalendar returns a Calendar in Vietnam time zone
+// with the given options applied
+func NewCalendar(options ...CalendarOption) *Calendar {
+	c := &Calendar{
+		zone:   fixedZone(defaultOffset()),
+		deltaT: deltat.EspenakMeeus,
+		reform: Reform1582,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	switch {
+	case c.calculation != nil:
+		c.zone = locationZone(c.calculation)
+	case c.location != nil:
+		c.zone = locationZone(c.location)
+	}
+	if c.location == nil {
+		c.location = VietNamTimeZone
+	}
+	return c
+}
+
+func (c *Calendar) Today() VNDate {
+	return c.FromSolarTime(time.Now())
+}
+
+// Date works as the package level Date using the calendar settings.
+//
+// Deprecated: Date does not check the supported range, use CheckedDate
+func (c *Calendar) Date(year int, month time.Month, day, hour, min, sec, nsec int) VNDate {
+	return c.FromSolarTime(time.Date(year, month, day, hour, min, sec, nsec, time.UTC))
+}
+
+// CheckedDate works as the package level CheckedDate using the calendar settings
+func (c *Calendar) CheckedDate(year int, month time.Month, day, hour, min, sec, nsec int) (VNDate, error) {
+	return checkSolarTime(c.Date(year, month, day, hour, min, sec, nsec))
+}
+
+// FromSolarTime works as the package level FromSolarTime in the calendar's time zone.
+//
+// Deprecated: FromSolarTime does not check the supported range,
+// use CheckedFromSolarTime
+func (c *Calendar) FromSolarTime(t time.Time) VNDate {
+	return newVNDate(t.In(c.location), c)
+}
+
+// CheckedFromSolarTime works as the package level CheckedFromSolarTime
+// in the calendar's time zone
+func (c *Calendar) CheckedFromSolarTime(t time.Time) (VNDate, error) {
+	return checkSolarTime(c.FromSolarTime(t))
+}
+
+// SolarTerms returns the 24 solar terms starting in the given solar year
+// in the calendar's time zone
+func (c *Calendar) SolarTerms(year int) []SolarTermEvent {
+	return solarTerms(c.astronomy(), year, c.location)
+}
+
+// Solar2lunar works as the package level Solar2lunar using the calendar settings.
+//
+// Deprecated: Solar2lunar does not validate the date, use CheckedSolar2lunar
+func (c *Calendar) Solar2lunar(yyyy, mm, dd int) LunarDate {
+	return c.converter().solar2lunar(yyyy, mm, dd)
+}
+
+// Lunar2solar works as the package level Lunar2solar using the calendar settings.
+//
+// Deprecated: Lunar2solar does not validate the date, use CheckedLunar2solar
+func (c *Calendar) Lunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool) SolarDate {
+	return c.converter().lunar2solar(lunarYear, lunarMonth, lunarDay, lunarLeap)
+}
+
+// CheckedSolar2lunar works as the package level CheckedSolar2lunar using the calendar settings
+func (c *Calendar) CheckedSolar2lunar(yyyy, mm, dd int) (LunarDate, error) {
+	return c.converter().checkedSolar2lunar(yyyy, mm, dd)
+}
+
+// CheckedLunar2solar works as the package level CheckedLunar2solar using the calendar settings
+func (c *Calendar) CheckedLunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool) (SolarDate, error) {
+	return c.converter().checkedLunar2solar(lunarYear, lunarMonth, lunarDay, lunarLeap)
+}
+
+// converter is safe to call on nil which means default settings
+func (c *Calendar) converter() converter {
+	if c == nil {
+		return newConverter(defaultAstronomy(), defaultOffset())
+	}
+	return converter{astronomy: c.astronomy(), zone: c.zone, reform: c.reform}
+}
+
+// astronomy is safe to call on nil which means default settings
+func (c *Calendar) astronomy() astronomy {
+	if c == nil {
+		return defaultAstronomy()
+	}
+	return c.engine.astronomy(c.deltaT)
+}
+
+// getDeltaT is safe to call on nil which means default settings
+func (c *Calendar) getDeltaT() deltat.Model {
+	if c == nil {
+		return deltat.EspenakMeeus
+	}
+	return c.deltaT
+}
+
+// getDayStartHour is safe to call on nil which means default settings
+func (c *Calendar) getDayStartHour() int {
+	if c == nil {
+		return 0
+	}
+	return c.dayStartHour
+}
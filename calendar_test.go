@@ -0,0 +1,68 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+)
+
+func TestNewCalendar(t *testing.T) {
+	c := NewCalendar()
+	d := c.Date(2014, time.September, 16, 15, 4, 0, 0)
+	y, m, day := d.Date()
+	assert.Equal(t, 2014, y)
+	assert.Equal(t, time.August, m)
+	assert.Equal(t, 23, day)
+	assert.Equal(t, VietNamTimeZone, d.SolarTime().Location())
+	assert.Equal(t, d.LunarDate(), d.NextDay().PreviousDay().LunarDate())
+}
+
+func TestWithDayStartHour(t *testing.T) {
+	// 23:30 the evening before Tết Giáp Thìn
+	solarTime := time.Date(2024, time.February, 9, 23, 30, 0, 0, VietNamTimeZone)
+
+	d := NewCalendar().FromSolarTime(solarTime)
+	assert.Equal(t, LunarDate{Year: 2023, Month: 12, Day: 30}, d.LunarDate())
+	assert.Equal(t, "Quý Mão", d.DayCanChi().String())
+	assert.Equal(t, "Giờ Giáp Tý", d.Hour().String())
+
+	c := NewCalendar(WithDayStartHour(23))
+	d = c.FromSolarTime(solarTime)
+	assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 1}, d.LunarDate())
+	assert.Equal(t, "Giáp Thìn", d.DayCanChi().String())
+	assert.Equal(t, "Giờ Giáp Tý", d.Hour().String())
+	assert.Equal(t, solarTime.Add(-30*time.Minute), d.Hours()[0].Start)
+	// the solar time is kept as is
+	assert.Equal(t, 9, d.SolarTime().Day())
+
+	d = c.FromSolarTime(solarTime.Add(-time.Hour))
+	assert.Equal(t, LunarDate{Year: 2023, Month: 12, Day: 30}, d.LunarDate())
+	assert.Equal(t, "Giờ Quý Hợi", d.Hour().String())
+
+	next := d.NextDay()
+	assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 1}, next.LunarDate())
+
+	// out of range hours are ignored
+	for _, hour := range []int{-1, 24, 47} {
+		d = NewCalendar(WithDayStartHour(23), WithDayStartHour(hour)).FromSolarTime(solarTime)
+		assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 1}, d.LunarDate(), "hour %d", hour)
+		d = NewCalendar(WithDayStartHour(hour)).FromSolarTime(solarTime)
+		assert.Equal(t, LunarDate{Year: 2023, Month: 12, Day: 30}, d.LunarDate(), "hour %d", hour)
+	}
+	assert.Equal(t, "Giờ Ất Hợi", next.Hour().String())
+}
+
+func TestWithDeltaT(t *testing.T) {
+	// an hour more ΔT than the default model at 2024,
+	// events in terrestrial time happen an hour earlier in universal time
+	table := deltat.NewTable([]deltat.Entry{{Year: 2024, Seconds: deltat.EspenakMeeus.Seconds(2024) + 3600}})
+	c := NewCalendar(WithDeltaT(table))
+	expected := SolarTerms(2024)
+	for i, e := range c.SolarTerms(2024) {
+		assert.Equal(t, expected[i].Term, e.Term)
+		assert.InDelta(t, -time.Hour, e.Time.Sub(expected[i].Time), float64(time.Minute))
+	}
+	assert.Equal(t, Solar2lunar(2024, 2, 10, TimeZoneOffset), c.Solar2lunar(2024, 2, 10))
+}
@@ -0,0 +1,94 @@
+package vncalendar
+
+import "fmt"
+
+// Can is one of the ten heavenly stems (Thiên can)
+type Can int
+
+const (
+	Giap Can = iota
+	At
+	Binh
+	Dinh
+	Mau
+	Ky
+	Canh
+	Tan
+	Nham
+	Quy
+)
+
+var canNames = []string{"Giáp", "Ất", "Bính", "Đinh", "Mậu", "Kỷ", "Canh", "Tân", "Nhâm", "Quý"}
+
+func (c Can) String() string {
+	return canNames[mod(int(c), 10)]
+}
+
+// Chi is one of the twelve earthly branches (Địa chi)
+type Chi int
+
+const (
+	Ty   Chi = iota // Tý
+	Suu             // Sửu
+	Dan             // Dần
+	Mao             // Mão
+	Thin            // Thìn
+	Ti              // Tỵ
+	Ngo             // Ngọ
+	Mui             // Mùi
+	Than            // Thân
+	Dau             // Dậu
+	Tuat            // Tuất
+	Hoi             // Hợi
+)
+
+var chiNames = []string{"Tý", "Sửu", "Dần", "Mão", "Thìn", "Tỵ", "Ngọ", "Mùi", "Thân", "Dậu", "Tuất", "Hợi"}
+
+func (c Chi) String() string {
+	return chiNames[mod(int(c), 12)]
+}
+
+// Clashes reports whether c and o are in opposition (lục xung),
+// e.g. Tý and Ngọ
+func (c Chi) Clashes(o Chi) bool {
+	return mod(int(c)-int(o), 12) == 6
+}
+
+// CanChi is a sexagenary cycle pair, e.g. Giáp Tý
+type CanChi struct {
+	Can Can
+	Chi Chi
+}
+
+func (c CanChi) String() string {
+	return fmt.Sprintf("%s %s", c.Can, c.Chi)
+}
+
+// YearCanChi returns Can-Chi of the given lunar year
+func YearCanChi(lunarYear int) CanChi {
+	return CanChi{Can: Can(mod(lunarYear+6, 10)), Chi: Chi(mod(lunarYear+8, 12))}
+}
+
+// MonthCanChi returns Can-Chi of the given lunar month,
+// a leap month shares Can-Chi with the month it follows
+func MonthCanChi(lunarYear, lunarMonth int) CanChi {
+	return CanChi{Can: Can(mod(lunarYear*12+lunarMonth+3, 10)), Chi: Chi(mod(lunarMonth+1, 12))}
+}
+
+// DayCanChi returns Can-Chi of the day with the given julian day number
+func DayCanChi(jdn int) CanChi {
+	return CanChi{Can: Can(mod(jdn+9, 10)), Chi: Chi(mod(jdn+1, 12))}
+}
+
+func (t VNDate) YearCanChi() CanChi {
+	return YearCanChi(t.lunarDate.Year)
+}
+
+func (t VNDate) MonthCanChi() CanChi {
+	return MonthCanChi(t.lunarDate.Year, t.lunarDate.Month)
+}
+
+// DayCanChi returns Can-Chi of the day, respecting the calendar's day start hour
+func (t VNDate) DayCanChi() CanChi {
+	return DayCanChi(t.JDN())
+}
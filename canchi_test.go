@@ -0,0 +1,39 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearCanChi(t *testing.T) {
+	assert.Equal(t, "Giáp Thìn", YearCanChi(2024).String())
+	assert.Equal(t, "Ất Tỵ", YearCanChi(2025).String())
+	assert.Equal(t, "Canh Ngọ", YearCanChi(1990).String())
+	assert.Equal(t, "Giáp Tý", YearCanChi(1984).String())
+}
+
+func TestMonthCanChi(t *testing.T) {
+	assert.Equal(t, "Bính Dần", MonthCanChi(2024, 1).String())
+	assert.Equal(t, "Đinh Hợi", MonthCanChi(2025, 10).String())
+	assert.Equal(t, "Mậu Dần", MonthCanChi(2025, 1).String())
+}
+
+func TestDayCanChi(t *testing.T) {
+	// Tết Giáp Thìn
+	d := Date(2024, time.February, 10, 12, 0, 0, 0)
+	assert.Equal(t, "Giáp Thìn", d.DayCanChi().String())
+	assert.Equal(t, "Giáp Thìn", d.YearCanChi().String())
+	assert.Equal(t, "Bính Dần", d.MonthCanChi().String())
+
+	d = Date(2000, time.January, 1, 12, 0, 0, 0)
+	assert.Equal(t, CanChi{Can: Mau, Chi: Ngo}, d.DayCanChi())
+}
+
+func TestChiClashes(t *testing.T) {
+	assert.True(t, Ty.Clashes(Ngo))
+	assert.True(t, Hoi.Clashes(Ti))
+	assert.False(t, Ty.Clashes(Suu))
+	assert.False(t, Ngo.Clashes(Ngo))
+}
@@ -0,0 +1,58 @@
+// Command vncal-server serves the calendar API of package api.
+//
+// Usage:
+//
+//	vncal-server [-addr :8080] [-prefix /calendar]
+//
+// GET /openapi.json describes the endpoints
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/vanng822/vncalendar/api"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	prefix := flag.String("prefix", "", "path prefix of the endpoints, e.g. /calendar")
+	flag.Parse()
+
+	var handler http.Handler = api.NewHandler()
+	if p := strings.TrimSuffix(*prefix, "/"); p != "" {
+		handler = http.StripPrefix(p, handler)
+	}
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	// closed when in-flight requests are done after a signal
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		server.Shutdown(shutdown)
+	}()
+
+	log.Printf("listening on %s", *addr)
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	<-done
+}
@@ -0,0 +1,345 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+)
+
+func convertFlags(fs *flag.FlagSet, ctx *cli) {
+	fs.BoolVar(&ctx.lunar, "lunar", false, "the date is a lunar date, convert it to solar")
+	fs.BoolVar(&ctx.leap, "leap", false, "the lunar date is in a leap month")
+}
+
+func nextFlags(fs *flag.FlagSet, ctx *cli) {
+	fs.BoolVar(&ctx.leap, "leap", false, "the lunar date is in a leap month")
+}
+
+func runConvert(ctx *cli, args []string) error {
+	if len(args) != 1 {
+		return usageError("expected one date YYYY-MM-DD")
+	}
+	if ctx.leap && !ctx.lunar {
+		return usageError("-leap requires -lunar")
+	}
+	var d vncalendar.VNDate
+	if ctx.lunar {
+		year, month, dd, err := parseDate(args[0])
+		if err != nil {
+			return err
+		}
+		solar, err := ctx.calendar.CheckedLunar2solar(year, month, dd, ctx.leap)
+		if err != nil {
+			return fmt.Errorf("lunar date %s: %w", args[0], err)
+		}
+		if d, err = ctx.date(solar.Year, time.Month(solar.Month), solar.Day); err != nil {
+			return err
+		}
+	} else {
+		parsed, err := vncalendar.ParseSolarDate(args[0])
+		if errors.Is(err, vncalendar.ErrInvalidDateFormat) {
+			return usageError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", args[0]))
+		}
+		if err != nil {
+			return fmt.Errorf("solar date %s: %w", args[0], err)
+		}
+		if d, err = ctx.date(parsed.SolarTime().Date()); err != nil {
+			return err
+		}
+	}
+	result := newDay(d)
+	return ctx.output(result, dayHeader, [][]string{result.row()}, result.writePlain)
+}
+
+func runMonth(ctx *cli, args []string) error {
+	today, err := ctx.today()
+	if err != nil {
+		return err
+	}
+	year, month := today.SolarTime().Year(), today.SolarTime().Month()
+	switch len(args) {
+	case 0:
+	case 2:
+		if year, err = parseYear(args[0]); err != nil {
+			return err
+		}
+		m, err := strconv.Atoi(args[1])
+		if err != nil || m < 1 || m > 12 {
+			return usageError(fmt.Sprintf("invalid month %q", args[1]))
+		}
+		month = time.Month(m)
+	default:
+		return usageError("expected year and month")
+	}
+	dates, err := monthDates(ctx.calendar, ctx.loc, year, month)
+	if err != nil {
+		return err
+	}
+	days := make([]day, 0, len(dates))
+	for _, d := range dates {
+		days = append(days, newDay(d))
+	}
+	return ctx.output(days, dayHeader, dayRows(days), func(w io.Writer) error {
+		return ctx.writeGrid(w, dates)
+	})
+}
+
+func runYear(ctx *cli, args []string) error {
+	today, err := ctx.today()
+	if err != nil {
+		return err
+	}
+	year := today.SolarTime().Year()
+	switch len(args) {
+	case 0:
+	case 1:
+		if year, err = parseYear(args[0]); err != nil {
+			return err
+		}
+	default:
+		return usageError("expected a year")
+	}
+	var months [][]vncalendar.VNDate
+	var days []day
+	for _, month := range vncalendar.Months {
+		dates, err := monthDates(ctx.calendar, ctx.loc, year, month)
+		if err != nil {
+			return err
+		}
+		months = append(months, dates)
+		for _, d := range dates {
+			days = append(days, newDay(d))
+		}
+	}
+	return ctx.output(days, dayHeader, dayRows(days), func(w io.Writer) error {
+		for i, dates := range months {
+			if i > 0 {
+				if _, err := fmt.Fprintln(w); err != nil {
+					return err
+				}
+			}
+			if err := ctx.writeGrid(w, dates); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// almanac is the summary of a day printed by today
+type almanac struct {
+	day
+	Time          string   `json:"time"`
+	Hour          string   `json:"hour"`
+	SolarTerm     string   `json:"solar_term"`
+	HoangDao      bool     `json:"hoang_dao"`
+	TamNuong      bool     `json:"tam_nuong"`
+	HoangDaoHours []string `json:"hoang_dao_hours"`
+	MoonPhase     string   `json:"moon_phase"`
+	MoonAge       float64  `json:"moon_age"`
+	Illumination  float64  `json:"illumination"`
+	Holidays      []string `json:"holidays"`
+}
+
+func runToday(ctx *cli, args []string) error {
+	if len(args) != 0 {
+		return usageError("today takes no arguments")
+	}
+	d, err := ctx.today()
+	if err != nil {
+		return err
+	}
+	moon := d.MoonPhase()
+	a := almanac{
+		day:          newDay(d),
+		Time:         d.SolarTime().Format("15:04"),
+		Hour:         d.Hour().String(),
+		SolarTerm:    d.SolarTerm().String(),
+		HoangDao:     d.IsHoangDao(),
+		TamNuong:     d.IsTamNuong(),
+		MoonPhase:    moon.Phase.String(),
+		MoonAge:      round(moon.Age, 1),
+		Illumination: round(moon.Illumination, 2),
+	}
+	for _, h := range d.Hours() {
+		if h.HoangDao {
+			a.HoangDaoHours = append(a.HoangDaoHours, fmt.Sprintf("%s (%s-%s)", h.Chi(), h.Start.Format("15:04"), h.End.Format("15:04")))
+		}
+	}
+	for _, h := range d.Holidays() {
+		a.Holidays = append(a.Holidays, h.Name)
+	}
+	header := append(dayHeader[:len(dayHeader):len(dayHeader)],
+		"time", "hour", "solar_term", "hoang_dao", "tam_nuong", "hoang_dao_hours",
+		"moon_phase", "moon_age", "illumination", "holidays")
+	row := append(a.row(),
+		a.Time, a.Hour, a.SolarTerm, strconv.FormatBool(a.HoangDao), strconv.FormatBool(a.TamNuong),
+		strings.Join(a.HoangDaoHours, "; "), a.MoonPhase,
+		strconv.FormatFloat(a.MoonAge, 'f', 1, 64), strconv.FormatFloat(a.Illumination, 'f', 2, 64),
+		strings.Join(a.Holidays, "; "))
+	return ctx.output(a, header, [][]string{row}, func(w io.Writer) error {
+		if err := a.writePlain(w); err != nil {
+			return err
+		}
+		kind := "hắc đạo"
+		if a.HoangDao {
+			kind = "hoàng đạo"
+		}
+		if a.TamNuong {
+			kind += ", Tam Nương"
+		}
+		lines := []string{
+			fmt.Sprintf("Bây giờ:    %s, %s", a.Time, a.Hour),
+			fmt.Sprintf("Ngày:       %s", kind),
+			fmt.Sprintf("Tiết khí:   %s", a.SolarTerm),
+			fmt.Sprintf("Giờ tốt:    %s", strings.Join(a.HoangDaoHours, ", ")),
+			fmt.Sprintf("Trăng:      %s, %.1f ngày tuổi, sáng %.0f%%", a.MoonPhase, a.MoonAge, a.Illumination*100),
+		}
+		if len(a.Holidays) > 0 {
+			lines = append(lines, fmt.Sprintf("Ngày lễ:    %s", strings.Join(a.Holidays, ", ")))
+		}
+		for _, line := range lines {
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// holiday is a row of holidays
+type holiday struct {
+	day
+	Name   string `json:"name"`
+	Public bool   `json:"public"`
+}
+
+func runHolidays(ctx *cli, args []string) error {
+	today, err := ctx.today()
+	if err != nil {
+		return err
+	}
+	year := today.SolarTime().Year()
+	switch len(args) {
+	case 0:
+	case 1:
+		if year, err = parseYear(args[0]); err != nil {
+			return err
+		}
+	default:
+		return usageError("expected a year")
+	}
+	var holidays []holiday
+	var rows [][]string
+	for _, h := range ctx.calendar.HolidaysIn(year) {
+		row := holiday{day: newDay(h.Date), Name: h.Name, Public: h.Public}
+		holidays = append(holidays, row)
+		rows = append(rows, append(row.row(), row.Name, strconv.FormatBool(row.Public)))
+	}
+	header := append(dayHeader[:len(dayHeader):len(dayHeader)], "name", "public")
+	return ctx.output(holidays, header, rows, func(w io.Writer) error {
+		for _, h := range holidays {
+			public := ""
+			if h.Public {
+				public = " *"
+			}
+			lunar := fmt.Sprintf("%02d/%02d", h.LunarDay, h.LunarMonth)
+			if _, err := fmt.Fprintf(w, "%s  %-8s  %s  %s%s\n", h.Solar, h.Weekday, lunar, h.Name, public); err != nil {
+				return err
+			}
+		}
+		_, err := fmt.Fprintln(w, "* ngày nghỉ lễ")
+		return err
+	})
+}
+
+// occurrence is the result of next
+type occurrence struct {
+	day
+	Days int `json:"days"`
+}
+
+func runNext(ctx *cli, args []string) error {
+	if len(args) != 1 {
+		return usageError("expected one lunar date MM-DD")
+	}
+	parts := strings.Split(args[0], "-")
+	if len(parts) != 2 {
+		return usageError(fmt.Sprintf("invalid lunar date %q", args[0]))
+	}
+	month, err1 := strconv.Atoi(parts[0])
+	dd, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil {
+		return usageError(fmt.Sprintf("invalid lunar date %q", args[0]))
+	}
+	today, err := ctx.today()
+	if err != nil {
+		return err
+	}
+	d, err := vncalendar.NextLunarDate(today, month, dd, ctx.leap)
+	if err != nil {
+		return err
+	}
+	o := occurrence{day: newDay(d), Days: d.JDN() - today.JDN()}
+	header := append(dayHeader[:len(dayHeader):len(dayHeader)], "days")
+	row := append(o.row(), strconv.Itoa(o.Days))
+	return ctx.output(o, header, [][]string{row}, func(w io.Writer) error {
+		if err := o.writePlain(w); err != nil {
+			return err
+		}
+		when := fmt.Sprintf("còn %d ngày", o.Days)
+		if o.Days == 0 {
+			when = "hôm nay"
+		}
+		_, err := fmt.Fprintf(w, "%s\n", when)
+		return err
+	})
+}
+
+func (ctx *cli) today() (vncalendar.VNDate, error) {
+	return ctx.calendar.CheckedFromSolarTime(now())
+}
+
+// date returns the solar date at noon in the calendar's time zone
+func (ctx *cli) date(year int, month time.Month, day int) (vncalendar.VNDate, error) {
+	return ctx.calendar.CheckedFromSolarTime(time.Date(year, month, day, 12, 0, 0, 0, ctx.loc))
+}
+
+// parseDate splits YYYY-MM-DD into numbers, the calendar checks the date
+func parseDate(s string) (year, month, day int, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return 0, 0, 0, usageError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", s))
+	}
+	year, err1 := strconv.Atoi(parts[0])
+	month, err2 := strconv.Atoi(parts[1])
+	day, err3 := strconv.Atoi(parts[2])
+	if err1 != nil || err2 != nil || err3 != nil {
+		return 0, 0, 0, usageError(fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", s))
+	}
+	return year, month, day, nil
+}
+
+// parseYear parses a year in the supported range
+func parseYear(s string) (int, error) {
+	year, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, usageError(fmt.Sprintf("invalid year %q", s))
+	}
+	if year < vncalendar.MinSupportedYear || year > vncalendar.MaxSupportedYear {
+		return 0, &vncalendar.RangeError{Year: year}
+	}
+	return year, nil
+}
+
+func round(x float64, decimals int) float64 {
+	p := math.Pow10(decimals)
+	return math.Round(x*p) / p
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/render"
+)
+
+// monthDates returns the dates of the solar month at noon
+func monthDates(c *vncalendar.Calendar, loc *time.Location, year int, month time.Month) ([]vncalendar.VNDate, error) {
+	var dates []vncalendar.VNDate
+	for t := time.Date(year, month, 1, 12, 0, 0, 0, loc); t.Month() == month; t = t.AddDate(0, 0, 1) {
+		d, err := c.CheckedFromSolarTime(t)
+		if err != nil {
+			return nil, err
+		}
+		dates = append(dates, d)
+	}
+	return dates, nil
+}
+
+func gridFlags(fs *flag.FlagSet, ctx *cli) {
+	fs.BoolVar(&ctx.sunday, "sunday", false, "start weeks on Sunday instead of Monday")
+	fs.StringVar(&ctx.color, "color", "auto", "ANSI colors: auto, always or never")
+}
+
+// writeGrid writes the month as a wall calendar, colored with -color always
+// or with auto when the output is a terminal and NO_COLOR is not set
+func (ctx *cli) writeGrid(w io.Writer, dates []vncalendar.VNDate) error {
+	var options []render.TerminalOption
+	if ctx.sunday {
+		options = append(options, render.WithWeekStart(time.Sunday))
+	}
+	if !ctx.useColor(w) {
+		options = append(options, render.WithoutColor())
+	}
+	_, err := io.WriteString(w, render.NewTerminal(options...).Month(dates))
+	return err
+}
+
+func (ctx *cli) useColor(w io.Writer) bool {
+	switch ctx.color {
+	case "always":
+		return true
+	case "auto":
+		f, ok := w.(*os.File)
+		if !ok || os.Getenv("NO_COLOR") != "" {
+			return false
+		}
+		info, err := f.Stat()
+		return err == nil && info.Mode()&os.ModeCharDevice != 0
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+// Command vncal converts dates and prints the Vietnamese lunar calendar.
+//
+// Usage:
+//
+//	vncal convert [-lunar] [-leap] [-tz zone] YYYY-MM-DD
+//	vncal month [-sunday] [-color auto|always|never] [-tz zone] [YYYY MM]
+//	vncal year [-sunday] [-color auto|always|never] [-tz zone] [YYYY]
+//	vncal today [-tz zone]
+//	vncal holidays [-tz zone] [YYYY]
+//	vncal next [-leap] [-tz zone] MM-DD
+//
+// Every command takes -format plain, json or csv. Solar dates are
+// proleptic Gregorian as time.Time, also before the 1582 reform
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/vanng822/vncalendar"
+)
+
+// now is replaced in tests
+var now = time.Now
+
+type command struct {
+	name, args, summary string
+	run                 func(ctx *cli, args []string) error
+	// flags adds the command's own flags
+	flags func(fs *flag.FlagSet, ctx *cli)
+}
+
+var commands = []command{
+	{name: "convert", args: "[-lunar] [-leap] YYYY-MM-DD", summary: "convert a solar date to lunar, or a lunar date to solar with -lunar", run: runConvert, flags: convertFlags},
+	{name: "month", args: "[-sunday] [-color mode] [YYYY MM]", summary: "print a month with lunar days under solar days", run: runMonth, flags: gridFlags},
+	{name: "year", args: "[-sunday] [-color mode] [YYYY]", summary: "print the twelve months of a year", run: runYear, flags: gridFlags},
+	{name: "today", summary: "print the almanac of today", run: runToday},
+	{name: "holidays", args: "[YYYY]", summary: "list festivals and public holidays of a year", run: runHolidays},
+	{name: "next", args: "[-leap] MM-DD", summary: "find the next occurrence of a lunar date", run: runNext, flags: nextFlags},
+}
+
+// cli holds the common flags and output of a command
+type cli struct {
+	stdout   io.Writer
+	format   string
+	tz       string
+	lunar    bool
+	leap     bool
+	sunday   bool
+	color    string
+	loc      *time.Location
+	calendar *vncalendar.Calendar
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return 2
+		}
+		return 0
+	}
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+		ctx := &cli{stdout: stdout}
+		fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+		fs.SetOutput(stderr)
+		fs.StringVar(&ctx.format, "format", formatPlain, "output format: plain, json or csv")
+		fs.StringVar(&ctx.tz, "tz", "", "time zone, an IANA name, Hanoi, Saigon or an offset such as +07:00")
+		if cmd.flags != nil {
+			cmd.flags(fs, ctx)
+		}
+		fs.Usage = func() {
+			fmt.Fprintf(stderr, "usage: vncal %s [-format plain|json|csv] [-tz zone] %s\n", cmd.name, cmd.args)
+			fs.PrintDefaults()
+		}
+		if err := fs.Parse(args[1:]); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return 0
+			}
+			return 2
+		}
+		if err := ctx.setup(); err != nil {
+			fmt.Fprintln(stderr, "vncal:", err)
+			return 2
+		}
+		if err := cmd.run(ctx, fs.Args()); err != nil {
+			fmt.Fprintln(stderr, "vncal:", err)
+			var usageErr usageError
+			if errors.As(err, &usageErr) {
+				fs.Usage()
+				return 2
+			}
+			return 1
+		}
+		return 0
+	}
+	fmt.Fprintf(stderr, "vncal: unknown command %q\n", args[0])
+	usage(stderr)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: vncal <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `run "vncal <command> -h" for the flags of a command`)
+}
+
+// usageError is a wrong argument, the usage of the command is printed
+type usageError string
+
+func (e usageError) Error() string {
+	return string(e)
+}
+
+func (ctx *cli) setup() error {
+	switch ctx.format {
+	case formatPlain, formatJSON, formatCSV:
+	default:
+		return fmt.Errorf("unknown format %q", ctx.format)
+	}
+	switch ctx.color {
+	case "", "auto", "always", "never":
+	default:
+		return fmt.Errorf("unknown color mode %q", ctx.color)
+	}
+	loc, err := parseLocation(ctx.tz)
+	if err != nil {
+		return err
+	}
+	options := []vncalendar.CalendarOption{vncalendar.WithReform(vncalendar.ProlepticGregorian)}
+	if loc == vncalendar.Hanoi || loc == vncalendar.Saigon {
+		// historical calendars, dates stay in Vietnam time
+		options = append(options, vncalendar.WithCalculationZone(loc))
+		loc = vncalendar.VietNamTimeZone
+	} else {
+		options = append(options, vncalendar.WithLocation(loc))
+	}
+	ctx.loc = loc
+	ctx.calendar = vncalendar.NewCalendar(options...)
+	return nil
+}
+
+var offsetRe = regexp.MustCompile(`^([+-])(\d{1,2})(?::?(\d{2}))?$`)
+
+// parseLocation accepts an IANA name, Hanoi and Saigon with the
+// historical offsets of their lunar calendars, or a fixed offset as +07:00, +0700 or +7
+func parseLocation(tz string) (*time.Location, error) {
+	switch tz {
+	case "":
+		return vncalendar.VietNamTimeZone, nil
+	case "Hanoi":
+		return vncalendar.Hanoi, nil
+	case "Saigon":
+		return vncalendar.Saigon, nil
+	}
+	if m := offsetRe.FindStringSubmatch(tz); m != nil {
+		hours, _ := strconv.Atoi(m[2])
+		minutes := 0
+		if m[3] != "" {
+			minutes, _ = strconv.Atoi(m[3])
+		}
+		if hours > 14 || minutes >= 60 {
+			return nil, fmt.Errorf("invalid time zone offset %q", tz)
+		}
+		offset := hours*3600 + minutes*60
+		if m[1] == "-" {
+			offset = -offset
+		}
+		return time.FixedZone(tz, offset), nil
+	}
+	return time.LoadLocation(tz)
+}
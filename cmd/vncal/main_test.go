@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar"
+)
+
+// vncal runs the command line at the given time in Vietnam
+func vncal(t *testing.T, at time.Time, args ...string) (stdout, stderr string, code int) {
+	t.Helper()
+	saved := now
+	now = func() time.Time { return at }
+	defer func() { now = saved }()
+	var out, errOut bytes.Buffer
+	code = run(args, &out, &errOut)
+	return out.String(), errOut.String(), code
+}
+
+var tet = time.Date(2025, time.January, 29, 10, 0, 0, 0, vncalendar.VietNamTimeZone)
+
+func TestConvert(t *testing.T) {
+	out, _, code := vncal(t, tet, "convert", "2025-01-29")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "Dương lịch: Thứ tư, 2025-01-29\nÂm lịch:    01/01/2025\nCan Chi:    năm Ất Tỵ, tháng Mậu Dần, ngày Mậu Tuất\n", out)
+
+	out, _, code = vncal(t, tet, "convert", "-format", "json", "-lunar", "-leap", "2025-06-15")
+	assert.Equal(t, 0, code)
+	var d day
+	assert.NoError(t, json.Unmarshal([]byte(out), &d))
+	assert.Equal(t, "2025-08-08", d.Solar)
+	assert.True(t, d.Leap)
+
+	out, _, code = vncal(t, tet, "convert", "-format", "csv", "-lunar", "2025-08-15")
+	assert.Equal(t, 0, code)
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, dayHeader, records[0])
+	assert.Equal(t, []string{"2025-10-06", "Thứ hai", "2025", "8", "15", "false", "Ất Tỵ", "Ất Dậu", "Mậu Thân"}, records[1])
+
+	// no leap month 4 in Giáp Thìn
+	_, stderr, code := vncal(t, tet, "convert", "-lunar", "-leap", "2024-04-01")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, vncalendar.ErrInvalidDate.Error())
+
+	_, _, code = vncal(t, tet, "convert", "2025-02-30")
+	assert.Equal(t, 1, code)
+	_, _, code = vncal(t, tet, "convert", "-leap", "2025-02-03")
+	assert.Equal(t, 2, code)
+	_, stderr, code = vncal(t, tet, "convert", "0999-01-01")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "not supported year range")
+	_, stderr, code = vncal(t, tet, "convert", "-lunar", "3000-12-01")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "not supported year range")
+	_, _, code = vncal(t, tet, "convert", "2025-2-3")
+	assert.Equal(t, 2, code)
+}
+
+func TestMonth(t *testing.T) {
+	out, _, code := vncal(t, tet, "month")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, `         Tháng 1 năm 2025
+   T2   T3   T4   T5   T6   T7   CN
+              1    2    3    4    5
+              2    3    4    5    6
+    6    7    8    9   10   11   12
+    7    8    9   10   11   12   13
+   13   14   15   16   17   18   19
+   14   15   16   17   18   19   20
+   20   21   22   23   24   25   26
+   21   22   23   24   25   26   27
+   27   28   29   30   31
+   28   29  1/1    2    3
+`, out)
+
+	// the leap month 6 of Ất Tỵ starts on 25 July
+	out, _, code = vncal(t, tet, "month", "2025", "7")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "1/6n")
+
+	_, _, code = vncal(t, tet, "month", "2025", "13")
+	assert.Equal(t, 2, code)
+}
+
+func TestYear(t *testing.T) {
+	out, _, code := vncal(t, tet, "year", "-format", "json", "2024")
+	assert.Equal(t, 0, code)
+	var days []day
+	assert.NoError(t, json.Unmarshal([]byte(out), &days))
+	assert.Len(t, days, 366)
+
+	out, _, code = vncal(t, tet, "year")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, 12, strings.Count(out, "năm 2025"))
+}
+
+func TestToday(t *testing.T) {
+	out, _, code := vncal(t, tet, "today")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "Âm lịch:    01/01/2025")
+	assert.Contains(t, out, "Bây giờ:    10:00, Giờ Đinh Tỵ")
+	assert.Contains(t, out, "Ngày lễ:    Tết Nguyên Đán")
+
+	out, _, code = vncal(t, tet, "today", "-format", "json")
+	assert.Equal(t, 0, code)
+	var a almanac
+	assert.NoError(t, json.Unmarshal([]byte(out), &a))
+	assert.Equal(t, 1, a.LunarDay)
+	assert.Equal(t, "Đại hàn", a.SolarTerm)
+	assert.Len(t, a.HoangDaoHours, 6)
+	assert.Equal(t, []string{"Tết Nguyên Đán"}, a.Holidays)
+	// the new moon is at 19:36
+	assert.Equal(t, "Hạ huyền", a.MoonPhase)
+
+	// in UTC it is still the last day of Giáp Thìn
+	out, _, code = vncal(t, time.Date(2025, time.January, 28, 20, 0, 0, 0, time.UTC), "today", "-tz", "UTC", "-format", "csv")
+	assert.Equal(t, 0, code)
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-01-28", records[1][0])
+	assert.Equal(t, "30", records[1][4])
+}
+
+func TestHolidays(t *testing.T) {
+	out, _, code := vncal(t, tet, "holidays", "-format", "csv")
+	assert.Equal(t, 0, code)
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, len(vncalendar.HolidaysIn(2025))+1)
+	assert.Equal(t, []string{"2025-01-01", "Tết Dương lịch", "true"}, []string{records[1][0], records[1][9], records[1][10]})
+
+	out, _, code = vncal(t, tet, "holidays", "2026")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "2026-02-17  Thứ ba    01/01  Tết Nguyên Đán *\n")
+}
+
+func TestNext(t *testing.T) {
+	at := time.Date(2025, time.October, 7, 9, 0, 0, 0, vncalendar.VietNamTimeZone)
+	out, _, code := vncal(t, at, "next", "08-15")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "Dương lịch: Thứ sáu, 2026-09-25\n")
+	assert.Contains(t, out, "còn 353 ngày\n")
+
+	out, _, code = vncal(t, at.AddDate(0, 0, -1), "next", "-format", "json", "08-15")
+	assert.Equal(t, 0, code)
+	var o occurrence
+	assert.NoError(t, json.Unmarshal([]byte(out), &o))
+	assert.Equal(t, 0, o.Days)
+
+	_, _, code = vncal(t, at, "next", "8/15")
+	assert.Equal(t, 2, code)
+}
+
+func TestParseLocation(t *testing.T) {
+	for tz, offset := range map[string]int{"": 7 * 3600, "+07:00": 7 * 3600, "+0530": 5*3600 + 1800, "-3": -3 * 3600, "Asia/Bangkok": 7 * 3600} {
+		loc, err := parseLocation(tz)
+		assert.NoError(t, err, tz)
+		_, got := time.Date(2025, time.January, 1, 0, 0, 0, 0, loc).Zone()
+		assert.Equal(t, offset, got, tz)
+	}
+	loc, err := parseLocation("Saigon")
+	assert.NoError(t, err)
+	assert.Equal(t, vncalendar.Saigon, loc)
+
+	// Tết Mậu Thân was a day later in the South
+	out, _, code := vncal(t, tet, "convert", "-tz", "Saigon", "-format", "json", "1968-01-29")
+	assert.Equal(t, 0, code)
+	var d day
+	assert.NoError(t, json.Unmarshal([]byte(out), &d))
+	assert.Equal(t, "1968-01-29", d.Solar)
+	assert.Equal(t, 30, d.LunarDay)
+	out, _, _ = vncal(t, tet, "convert", "-tz", "Hanoi", "-format", "json", "1968-01-29")
+	assert.NoError(t, json.Unmarshal([]byte(out), &d))
+	assert.Equal(t, 1, d.LunarDay)
+
+	_, err = parseLocation("+25")
+	assert.Error(t, err)
+	_, err = parseLocation("Mars/Olympus")
+	assert.Error(t, err)
+}
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, 0.5, round(0.45, 1))
+	assert.Equal(t, -0.5, round(-0.45, 1))
+	assert.Equal(t, -3.0, round(-2.5, 0))
+	assert.Equal(t, 0.99, round(0.986, 2))
+}
+
+func TestUsage(t *testing.T) {
+	_, stderr, code := vncal(t, tet)
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "usage: vncal <command>")
+
+	_, stderr, code = vncal(t, tet, "calendar")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown command "calendar"`)
+
+	_, stderr, code = vncal(t, tet, "today", "-format", "xml")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown format "xml"`)
+
+	_, _, code = vncal(t, tet, "help")
+	assert.Equal(t, 0, code)
+}
+
+func TestMonthFlags(t *testing.T) {
+	out, _, code := vncal(t, tet, "month", "-sunday", "2025", "6")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "   CN   T2   T3   T4   T5   T6   T7\n    1    2")
+	assert.NotContains(t, out, "\x1b[")
+
+	out, _, code = vncal(t, tet, "month", "-color", "always", "2025", "11")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out, "\x1b[33m")
+
+	_, _, code = vncal(t, tet, "year", "-color", "rainbow")
+	assert.Equal(t, 2, code)
+}
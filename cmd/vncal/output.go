@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/vanng822/vncalendar"
+)
+
+const (
+	formatPlain = "plain"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// output writes v as JSON, header and rows as CSV or calls plain
+func (ctx *cli) output(v any, header []string, rows [][]string, plain func(w io.Writer) error) error {
+	switch ctx.format {
+	case formatJSON:
+		enc := json.NewEncoder(ctx.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		w := csv.NewWriter(ctx.stdout)
+		if err := w.Write(header); err != nil {
+			return err
+		}
+		if err := w.WriteAll(rows); err != nil {
+			return err
+		}
+		return w.Error()
+	}
+	return plain(ctx.stdout)
+}
+
+var weekdayNames = []string{"Chủ nhật", "Thứ hai", "Thứ ba", "Thứ tư", "Thứ năm", "Thứ sáu", "Thứ bảy"}
+
+// day is a solar date with its lunar date and Can-Chi
+type day struct {
+	Solar       string `json:"solar"`
+	Weekday     string `json:"weekday"`
+	LunarYear   int    `json:"lunar_year"`
+	LunarMonth  int    `json:"lunar_month"`
+	LunarDay    int    `json:"lunar_day"`
+	Leap        bool   `json:"leap"`
+	YearCanChi  string `json:"year_can_chi"`
+	MonthCanChi string `json:"month_can_chi"`
+	DayCanChi   string `json:"day_can_chi"`
+}
+
+var dayHeader = []string{"solar", "weekday", "lunar_year", "lunar_month", "lunar_day", "leap", "year_can_chi", "month_can_chi", "day_can_chi"}
+
+func newDay(d vncalendar.VNDate) day {
+	lunar := d.LunarDate()
+	return day{
+		Solar:       d.SolarTime().Format("2006-01-02"),
+		Weekday:     weekdayNames[d.SolarTime().Weekday()],
+		LunarYear:   lunar.Year,
+		LunarMonth:  lunar.Month,
+		LunarDay:    lunar.Day,
+		Leap:        lunar.Leap,
+		YearCanChi:  d.YearCanChi().String(),
+		MonthCanChi: d.MonthCanChi().String(),
+		DayCanChi:   d.DayCanChi().String(),
+	}
+}
+
+func (d day) row() []string {
+	return []string{
+		d.Solar, d.Weekday,
+		strconv.Itoa(d.LunarYear), strconv.Itoa(d.LunarMonth), strconv.Itoa(d.LunarDay),
+		strconv.FormatBool(d.Leap), d.YearCanChi, d.MonthCanChi, d.DayCanChi,
+	}
+}
+
+// lunar returns the lunar date as DD/MM/YYYY with the leap month marked
+func (d day) lunar() string {
+	s := fmt.Sprintf("%02d/%02d/%04d", d.LunarDay, d.LunarMonth, d.LunarYear)
+	if d.Leap {
+		s += " (tháng nhuận)"
+	}
+	return s
+}
+
+func (d day) writePlain(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "Dương lịch: %s, %s\nÂm lịch:    %s\nCan Chi:    năm %s, tháng %s, ngày %s\n",
+		d.Weekday, d.Solar, d.lunar(), d.YearCanChi, d.MonthCanChi, d.DayCanChi)
+	return err
+}
+
+func dayRows(days []day) [][]string {
+	rows := make([][]string, 0, len(days))
+	for _, d := range days {
+		rows = append(rows, d.row())
+	}
+	return rows
+}
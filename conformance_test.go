@@ -0,0 +1,260 @@
+package vncalendar
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lunarYear is a row of testdata/lunar_years.csv, generated by
+// testdata/gen_lunar_years.py. The script is not independent of the Go
+// code: it implements the same algorithms as PrecisionEngine, Meeus
+// chapters 49 and 25 with the Espenak-Meeus ΔT, in another language.
+// The dataset catches porting and conversion bugs, not errors of the
+// algorithms. It has not been checked against a published table as a
+// whole, TestGoldenPublished spot checks it with years of known calendars
+type lunarYear struct {
+	year         int
+	tet          time.Time
+	leapMonth    int
+	monthLengths []int
+}
+
+func loadLunarYears(t *testing.T) []lunarYear {
+	f, err := os.Open("testdata/lunar_years.csv")
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+
+	var years []lunarYear
+	for _, record := range records[1:] {
+		year, err := strconv.Atoi(record[0])
+		require.NoError(t, err)
+		tet, err := time.Parse("2006-01-02", record[1])
+		require.NoError(t, err)
+		leapMonth, err := strconv.Atoi(record[2])
+		require.NoError(t, err)
+		var lengths []int
+		for _, field := range strings.Fields(record[3]) {
+			length, err := strconv.Atoi(field)
+			require.NoError(t, err)
+			lengths = append(lengths, length)
+		}
+		years = append(years, lunarYear{year: year, tet: tet, leapMonth: leapMonth, monthLengths: lengths})
+	}
+	return years
+}
+
+// goldenDay is a solar day with its expected lunar date
+type goldenDay struct {
+	solar SolarDate
+	lunar LunarDate
+	// last is true on the last day of the lunar month
+	last bool
+}
+
+// goldenDays expands the lunar years into every day they cover
+func goldenDays(t *testing.T) []goldenDay {
+	var days []goldenDay
+	years := loadLunarYears(t)
+	for i, y := range years {
+		if i > 0 {
+			// years are consecutive and each starts when the previous ends
+			require.Equal(t, years[i-1].year+1, y.year)
+			require.Equal(t, days[len(days)-1].solar, toSolarDate(y.tet.AddDate(0, 0, -1)), "Tết %d", y.year)
+		}
+		require.Len(t, y.monthLengths, 12+min(y.leapMonth, 1), "year %d", y.year)
+		day := y.tet
+		month := 0
+		for i, length := range y.monthLengths {
+			leap := y.leapMonth > 0 && i == y.leapMonth
+			if !leap {
+				month++
+			}
+			for d := 1; d <= length; d++ {
+				days = append(days, goldenDay{
+					solar: toSolarDate(day),
+					lunar: LunarDate{Year: y.year, Month: month, Day: d, Leap: leap},
+					last:  d == length,
+				})
+				day = day.AddDate(0, 0, 1)
+			}
+		}
+	}
+	return days
+}
+
+func toSolarDate(t time.Time) SolarDate {
+	return SolarDate{Year: t.Year(), Month: int(t.Month()), Day: t.Day()}
+}
+
+func TestGoldenLunarYears(t *testing.T) {
+	years := loadLunarYears(t)
+	require.Len(t, years, 201)
+	for _, y := range years {
+		assert.Equal(t, toSolarDate(y.tet), Lunar2solar(y.year, 1, 1, false, TimeZoneOffset), "Tết %d", y.year)
+		if y.leapMonth > 0 {
+			first := Lunar2solar(y.year, y.leapMonth, 1, true, TimeZoneOffset)
+			assert.True(t, Solar2lunar(first.Year, first.Month, first.Day, TimeZoneOffset).Leap, "leap month %d of %d", y.leapMonth, y.year)
+		}
+	}
+}
+
+// publishedYears are Tết and leap months of printed Vietnamese calendars,
+// independent of the algorithms of the dataset. 1968 is Tết in the North,
+// 1985 and 2007 are a month and a day before the Chinese new year
+var publishedYears = []struct {
+	year      int
+	tet       string
+	leapMonth int
+}{
+	{1968, "1968-01-29", 7},
+	{1985, "1985-01-21", -1},
+	{2001, "2001-01-24", 4},
+	{2004, "2004-01-22", 2},
+	{2006, "2006-01-29", 7},
+	{2007, "2007-02-17", 0},
+	{2009, "2009-01-26", 5},
+	{2012, "2012-01-23", 4},
+	{2014, "2014-01-31", 9},
+	{2017, "2017-01-28", 6},
+	{2020, "2020-01-25", 4},
+	{2023, "2023-01-22", 2},
+	{2024, "2024-02-10", 0},
+	{2025, "2025-01-29", 6},
+}
+
+// TestGoldenPublished checks the dataset against publishedYears,
+// a leap month of -1 is not checked
+func TestGoldenPublished(t *testing.T) {
+	years := map[int]lunarYear{}
+	for _, y := range loadLunarYears(t) {
+		years[y.year] = y
+	}
+	for _, p := range publishedYears {
+		y, ok := years[p.year]
+		require.True(t, ok, p.year)
+		assert.Equal(t, p.tet, y.tet.Format("2006-01-02"), "Tết %d", p.year)
+		if p.leapMonth >= 0 {
+			assert.Equal(t, p.leapMonth, y.leapMonth, "leap month of %d", p.year)
+		}
+	}
+}
+
+// standardExceptions are the lunar months where the standard engine is
+// known to disagree with the dataset, they are only checked with the
+// precision engine
+var standardExceptions = map[LunarDate]bool{
+	// the new moon is less than a minute before midnight on 20 June 1944,
+	// the standard engine has it 59 seconds after midnight on 21 June.
+	// The dataset follows the precision engine, the month is not in the
+	// published years of TestGoldenPublished
+	{Year: 1944, Month: 5, Day: 1}: true,
+}
+
+// TestStandardExceptions keeps the list of exceptions to months
+// that still disagree
+func TestStandardExceptions(t *testing.T) {
+	found := 0
+	for _, d := range goldenDays(t) {
+		if d.lunar.Day != 1 || !standardExceptions[d.lunar] {
+			continue
+		}
+		found++
+		assert.NotEqual(t, d.solar, Lunar2solar(d.lunar.Year, d.lunar.Month, 1, d.lunar.Leap, TimeZoneOffset), "%v", d.lunar)
+	}
+	assert.Equal(t, len(standardExceptions), found)
+}
+
+func (d goldenDay) month() LunarDate {
+	month := d.lunar
+	month.Day = 1
+	return month
+}
+
+func TestGoldenSolar2lunar(t *testing.T) {
+	borderline := standardExceptions
+	precision := NewCalendar(WithEngine(PrecisionEngine))
+	for _, d := range goldenDays(t) {
+		if !assert.Equal(t, d.lunar, precision.Solar2lunar(d.solar.Year, d.solar.Month, d.solar.Day), "%v", d.solar) {
+			return
+		}
+		if borderline[d.month()] {
+			continue
+		}
+		if !assert.Equal(t, d.lunar, Solar2lunar(d.solar.Year, d.solar.Month, d.solar.Day, TimeZoneOffset), "%v", d.solar) {
+			return
+		}
+	}
+}
+
+func TestGoldenLunar2solar(t *testing.T) {
+	borderline := standardExceptions
+	precision := NewCalendar(WithEngine(PrecisionEngine))
+	for _, d := range goldenDays(t) {
+		if !assert.Equal(t, d.solar, precision.Lunar2solar(d.lunar.Year, d.lunar.Month, d.lunar.Day, d.lunar.Leap), "%v", d.lunar) {
+			return
+		}
+		if borderline[d.month()] {
+			continue
+		}
+		if !assert.Equal(t, d.solar, Lunar2solar(d.lunar.Year, d.lunar.Month, d.lunar.Day, d.lunar.Leap, TimeZoneOffset), "%v", d.lunar) {
+			return
+		}
+	}
+}
+
+func TestGoldenGetMonthDates(t *testing.T) {
+	borderline := standardExceptions
+	expected := make(map[SolarDate]goldenDay)
+	for _, d := range goldenDays(t) {
+		expected[d.solar] = d
+	}
+	for year := 1900; year <= 2100; year++ {
+		for _, month := range Months {
+			for _, d := range GetMonthDates(year, month) {
+				solar := toSolarDate(d.SolarTime())
+				want, ok := expected[solar]
+				// before Tết 1900
+				if !ok || borderline[want.month()] {
+					continue
+				}
+				if !assert.Equal(t, want.lunar, d.LunarDate(), "%v", solar) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestGoldenLastDayOfMonth(t *testing.T) {
+	borderline := standardExceptions
+	days := goldenDays(t)
+	// last[i] is the index of the last day of the month of days[i]
+	last := make([]int, len(days))
+	for i := len(days) - 1; i >= 0; i-- {
+		if days[i].last {
+			last[i] = i
+		} else {
+			last[i] = last[i+1]
+		}
+	}
+	for i, d := range days {
+		j := last[i]
+		// the last day moves with the start of the next month
+		if borderline[d.month()] || (j+1 < len(days) && borderline[days[j+1].month()]) {
+			continue
+		}
+		date := Date(d.solar.Year, time.Month(d.solar.Month), d.solar.Day, 5, 0, 0, 0)
+		if !assert.Equal(t, days[j].lunar, date.LastDayOfMonth().LunarDate(), "%v", d.solar) {
+			return
+		}
+	}
+}
@@ -2,6 +2,7 @@ package vncalendar
 
 import (
 	"math"
+	"time"
 )
 
 type SolarDate struct {
@@ -13,46 +14,20 @@ type LunarDate struct {
 	Leap             bool
 }
 
+// jdFromDate returns julian day number of a solar date with the 1582 reform
 func jdFromDate(dd, mm, yyyy int) int {
-	var a, y, m, jd int
-	a = int((14 - mm) / 12)
-	y = yyyy + 4800 - a
-	m = mm + 12*a - 3
-	jd = dd + int((153*m+2)/5) + 365*y + int(y/4) - int(y/100) + int(y/400) - 32045
-	if jd < 2299161 {
-		jd = dd + int((153*m+2)/5) + 365*y + int(y/4) - 32083
-	}
-	return jd
+	return Reform1582.JDN(yyyy, mm, dd)
 }
 
+// jdToDate returns solar date of a julian day number with the 1582 reform
 func jdToDate(jd int) SolarDate {
-	var a, b, c, d, e, m, day, month, year int
-	date := SolarDate{}
-
-	if jd > 2299160 {
-		// After 5/10/1582, Gregorian calendar
-		a = jd + 32044
-		b = int((4*a + 3) / 146097)
-		c = a - int((b*146097)/4)
-	} else {
-		b = 0
-		c = jd + 32082
-	}
-	d = int((4*c + 3) / 1461)
-	e = c - int((1461*d)/4)
-	m = int((5*e + 2) / 153)
-	day = e - int((153*m+2)/5) + 1
-	month = m + 3 - 12*int(m/10)
-	year = b*100 + d - 4800 + int(m/10)
-	date.Day = day
-	date.Month = month
-	date.Year = year
-
-	return date
+	return Reform1582.Date(jd)
 }
 
+// newMoon returns julian ephemeris day, in terrestrial time, of the k-th new moon
+// after 1900-01-01, deltat.Model converts it to universal time
 func newMoon(ak int) float64 {
-	var T, T2, T3, dr, Jd1, M, mPr, F, C1, deltat float64
+	var T, T2, T3, dr, Jd1, M, mPr, F, C1 float64
 	k := float64(ak)
 	T = k / 1236.85 // Time in Julian centuries from 1900 January 0.5
 	T2 = T * T
@@ -70,15 +45,11 @@ func newMoon(ak int) float64 {
 	C1 = C1 - 0.0074*math.Sin(dr*(M-mPr)) + 0.0004*math.Sin(dr*(2*F+M))
 	C1 = C1 - 0.0004*math.Sin(dr*(2*F-M)) - 0.0006*math.Sin(dr*(2*F+mPr))
 	C1 = C1 + 0.0010*math.Sin(dr*(2*F-mPr)) + 0.0005*math.Sin(dr*(2*mPr+M))
-	if T < -11 {
-		deltat = 0.001 + 0.000839*T + 0.0002261*T2 - 0.00000845*T3 - 0.000000081*T*T3
-	} else {
-		deltat = -0.000278 + 0.000265*T + 0.000262*T2
-	}
 
-	return Jd1 + C1 - deltat
+	return Jd1 + C1
 }
 
+// sunLongitude returns the true sun longitude in radians at julian ephemeris day
 func sunLongitude(jdn float64) float64 {
 	var T, T2, dr, M, L0, DL, L float64
 	T = (jdn - 2451545.0) / 36525.0 // Time in Julian centuries from 2000-01-01 12:00:00 GMT
@@ -90,72 +61,134 @@ func sunLongitude(jdn float64) float64 {
 	DL = DL + (0.019993-0.000101*T)*math.Sin(dr*2*M) + 0.000290*math.Sin(dr*3*M)
 	L = L0 + DL // true longitude, degree
 	L = L * dr
-	L = L - math.Pi*2*float64(int(L/(math.Pi*2))) // Normalize to (0, 2*PI)
+	L = L - math.Pi*2*math.Floor(L/(math.Pi*2)) // Normalize to [0, 2*PI)
 	return L
 }
 
-func getSunLongitude(jd, timeZoneOffset int) int {
-	return int(sunLongitude(float64(jd)-float64(0.5)-float64(timeZoneOffset)/24.0) / math.Pi * 6)
+// converter converts between solar and lunar dates
+// with the given astronomy and time zone
+type converter struct {
+	astronomy astronomy
+	zone      zone
+	// reform of solar dates, julian day numbers are
+	// always computed with the 1582 reform internally
+	reform Reform
+}
+
+func newConverter(a astronomy, offset time.Duration) converter {
+	return converter{astronomy: a, zone: fixedZone(offset), reform: Reform1582}
+}
+
+// midnight returns julian day in UT of the local midnight starting the day
+func (c converter) midnight(day int) float64 {
+	jd := float64(day) - 0.5
+	return jd - c.zone(jd-c.zone(jd))
 }
 
-func getNewMoonDay(k, timeZoneOffset int) int {
-	return int(newMoon(k) + 0.5 + float64(timeZoneOffset)/24)
+// localDay returns the local julian day number at julian day in UT
+func (c converter) localDay(jd float64) int {
+	return int(jd + 0.5 + c.zone(jd))
 }
 
-func getLunarMonth11(yyyy, timeZoneOffset int) int {
+func (c converter) getSunLongitude(jd int) int {
+	return int(c.astronomy.sunLongitude(c.midnight(jd)) / math.Pi * 6)
+}
+
+func (c converter) getNewMoonDay(k int) int {
+	return c.localDay(c.astronomy.newMoon(k))
+}
+
+func (c converter) getLunarMonth11(yyyy int) int {
 	var k, off, nm, sunLong int
 	off = jdFromDate(31, 12, yyyy) - 2415021
-	k = int(float64(off) / 29.530588853)
-	nm = getNewMoonDay(k, timeZoneOffset)
-	sunLong = getSunLongitude(nm, timeZoneOffset) // sun longitude at local midnight
+	k = int(math.Floor(float64(off) / 29.530588853))
+	nm = c.getNewMoonDay(k)
+	sunLong = c.getSunLongitude(nm) // sun longitude at local midnight
 	if sunLong >= 9 {
-		nm = getNewMoonDay(k-1, timeZoneOffset)
+		nm = c.getNewMoonDay(k - 1)
 	}
 	return nm
 }
 
-func getLeapMonthOffset(a11, timeZoneOffset int) int {
+func (c converter) getLeapMonthOffset(a11 int) int {
 	var k, last, arc, i int
-	k = int((float64(a11)-2415021.076998695)/29.530588853 + 0.5)
+	k = int(math.Floor((float64(a11)-2415021.076998695)/29.530588853 + 0.5))
 	last = 0
 	i = 1 // We start with the month following lunar month 11
-	arc = getSunLongitude(getNewMoonDay(k+i, timeZoneOffset), timeZoneOffset)
+	arc = c.getSunLongitude(c.getNewMoonDay(k + i))
 
 	for ok := true; ok; ok = arc != last && i < 14 {
 		last = arc
 		i++
-		arc = getSunLongitude(getNewMoonDay(k+i, timeZoneOffset), timeZoneOffset)
+		arc = c.getSunLongitude(c.getNewMoonDay(k + i))
 	}
 	return i - 1
 }
 
+// Solar2lunar converts a solar date, the Julian calendar before the 1582 reform.
+//
+// Deprecated: Solar2lunar does not validate the date, use CheckedSolar2lunar
 func Solar2lunar(yyyy, mm, dd, timeZoneOffset int) LunarDate {
-	var k, dayNumber, monthStart, a11, b11, lunarDay, lunarMonth, lunarYear,
+	return Solar2lunarOffset(yyyy, mm, dd, time.Duration(timeZoneOffset)*time.Hour)
+}
+
+// CheckedSolar2lunar works as Solar2lunar with the errors of ParseDate
+// for an invalid date and *RangeError for a year that is not supported
+func CheckedSolar2lunar(yyyy, mm, dd, timeZoneOffset int) (LunarDate, error) {
+	return newConverter(defaultAstronomy(), time.Duration(timeZoneOffset)*time.Hour).checkedSolar2lunar(yyyy, mm, dd)
+}
+
+// Solar2lunarOffset works as Solar2lunar with an offset
+// that is not whole hours, e.g. 5*time.Hour + 30*time.Minute.
+//
+// Deprecated: Solar2lunarOffset does not validate the date, use
+// Calendar.CheckedSolar2lunar with WithCalculationZone of a time.FixedZone
+func Solar2lunarOffset(yyyy, mm, dd int, offset time.Duration) LunarDate {
+	return newConverter(defaultAstronomy(), offset).solar2lunar(yyyy, mm, dd)
+}
+
+func (c converter) solar2lunar(yyyy, mm, dd int) LunarDate {
+	return c.lunarDate(c.reform.JDN(yyyy, mm, dd))
+}
+
+func (c converter) checkedSolar2lunar(yyyy, mm, dd int) (LunarDate, error) {
+	if err := checkSolar(c.reform, yyyy, mm, dd); err != nil {
+		return LunarDate{}, err
+	}
+	return c.solar2lunar(yyyy, mm, dd), nil
+}
+
+// lunarDate returns the lunar date of a julian day number
+func (c converter) lunarDate(dayNumber int) LunarDate {
+	var k, monthStart, a11, b11, lunarDay, lunarMonth, lunarYear,
 		diff, leapMonthDiff int
 	var lunarLeap bool
 
-	dayNumber = jdFromDate(dd, mm, yyyy)
+	yyyy := jdToDate(dayNumber).Year
 
-	k = int((float64(dayNumber) - 2415021.076998695) / 29.530588853)
-	monthStart = getNewMoonDay(k+1, timeZoneOffset)
-	if monthStart > dayNumber {
-		monthStart = getNewMoonDay(k, timeZoneOffset)
+	k = int(math.Floor((float64(dayNumber) - 2415021.076998695) / 29.530588853))
+	monthStart = c.getNewMoonDay(k + 1)
+	// mean lunations drift from true new moons by more than a day
+	// far from 1900, step back until the month starts on or before the day
+	for monthStart > dayNumber {
+		k--
+		monthStart = c.getNewMoonDay(k + 1)
 	}
-	a11 = getLunarMonth11(yyyy, timeZoneOffset)
+	a11 = c.getLunarMonth11(yyyy)
 	b11 = a11
 	if a11 >= monthStart {
 		lunarYear = yyyy
-		a11 = getLunarMonth11(yyyy-1, timeZoneOffset)
+		a11 = c.getLunarMonth11(yyyy - 1)
 	} else {
 		lunarYear = yyyy + 1
-		b11 = getLunarMonth11(yyyy+1, timeZoneOffset)
+		b11 = c.getLunarMonth11(yyyy + 1)
 	}
 	lunarDay = dayNumber - monthStart + 1
 	diff = int((monthStart - a11) / 29)
 	lunarLeap = false
 	lunarMonth = diff + 11
 	if b11-a11 > 365 {
-		leapMonthDiff = getLeapMonthOffset(a11, timeZoneOffset)
+		leapMonthDiff = c.getLeapMonthOffset(a11)
 		if diff >= leapMonthDiff {
 			lunarMonth = diff + 10
 			if diff == leapMonthDiff {
@@ -177,33 +210,74 @@ func Solar2lunar(yyyy, mm, dd, timeZoneOffset int) LunarDate {
 	return res
 }
 
+// Lunar2solar converts a lunar date to a solar date, the Julian calendar
+// before the 1582 reform. It returns the zero SolarDate for a leap month
+// that does not exist.
+//
+// Deprecated: Lunar2solar does not validate the date, use CheckedLunar2solar
 func Lunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool, timeZoneOffset int) SolarDate {
+	return Lunar2solarOffset(lunarYear, lunarMonth, lunarDay, lunarLeap, time.Duration(timeZoneOffset)*time.Hour)
+}
+
+// CheckedLunar2solar works as Lunar2solar with the errors of ParseLunarDate
+// for an invalid date and *RangeError for a year that is not supported
+func CheckedLunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool, timeZoneOffset int) (SolarDate, error) {
+	return newConverter(defaultAstronomy(), time.Duration(timeZoneOffset)*time.Hour).checkedLunar2solar(lunarYear, lunarMonth, lunarDay, lunarLeap)
+}
+
+// Lunar2solarOffset works as Lunar2solar with an offset
+// that is not whole hours, e.g. 5*time.Hour + 45*time.Minute.
+//
+// Deprecated: Lunar2solarOffset does not validate the date, use
+// Calendar.CheckedLunar2solar with WithCalculationZone of a time.FixedZone
+func Lunar2solarOffset(lunarYear, lunarMonth, lunarDay int, lunarLeap bool, offset time.Duration) SolarDate {
+	return newConverter(defaultAstronomy(), offset).lunar2solar(lunarYear, lunarMonth, lunarDay, lunarLeap)
+}
+
+func (c converter) lunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool) SolarDate {
+	jdn := c.lunarJDN(lunarYear, lunarMonth, lunarDay, lunarLeap)
+	if jdn == 0 {
+		return SolarDate{Day: 0, Month: 0, Year: 0}
+	}
+	return c.reform.Date(jdn)
+}
+
+func (c converter) checkedLunar2solar(lunarYear, lunarMonth, lunarDay int, lunarLeap bool) (SolarDate, error) {
+	if err := c.checkLunar(lunarYear, lunarMonth, lunarDay, lunarLeap); err != nil {
+		return SolarDate{}, err
+	}
+	return c.lunar2solar(lunarYear, lunarMonth, lunarDay, lunarLeap), nil
+}
+
+// lunarJDN returns the julian day number of a lunar date,
+// 0 if the leap month does not exist
+func (c converter) lunarJDN(lunarYear, lunarMonth, lunarDay int, lunarLeap bool) int {
 	var k, a11, b11, off, leapOff, leapMonth, monthStart int
 
 	if lunarMonth < 11 {
-		a11 = getLunarMonth11(lunarYear-1, timeZoneOffset)
-		b11 = getLunarMonth11(lunarYear, timeZoneOffset)
+		a11 = c.getLunarMonth11(lunarYear - 1)
+		b11 = c.getLunarMonth11(lunarYear)
 	} else {
-		a11 = getLunarMonth11(lunarYear, timeZoneOffset)
-		b11 = getLunarMonth11(lunarYear+1, timeZoneOffset)
+		a11 = c.getLunarMonth11(lunarYear)
+		b11 = c.getLunarMonth11(lunarYear + 1)
 	}
-	k = int(0.5 + (float64(a11)-2415021.076998695)/29.530588853)
+	k = int(math.Floor(0.5 + (float64(a11)-2415021.076998695)/29.530588853))
 	off = lunarMonth - 11
 	if off < 0 {
 		off += 12
 	}
 	if b11-a11 > 365 {
-		leapOff = getLeapMonthOffset(a11, timeZoneOffset)
+		leapOff = c.getLeapMonthOffset(a11)
 		leapMonth = leapOff - 2
-		if leapMonth < 0 {
+		if leapMonth <= 0 {
 			leapMonth += 12
 		}
 		if lunarLeap && lunarMonth != leapMonth {
-			return SolarDate{Day: 0, Month: 0, Year: 0}
+			return 0
 		} else if lunarLeap || off >= leapOff {
 			off += 1
 		}
 	}
-	monthStart = getNewMoonDay(k+off, timeZoneOffset)
-	return jdToDate(monthStart + lunarDay - 1)
+	monthStart = c.getNewMoonDay(k + off)
+	return monthStart + lunarDay - 1
 }
@@ -2,6 +2,7 @@ package vncalendar
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -68,3 +69,28 @@ func TestLunar2SolarLeapMonth(t *testing.T) {
 	assert.Equal(t, 6, solarDate.Month)
 	assert.Equal(t, 2012, solarDate.Year)
 }
+
+func TestSolar2LunarBefore2000(t *testing.T) {
+	// Tết differs from China in 1985
+	assert.Equal(t, LunarDate{Year: 1985, Month: 1, Day: 1}, Solar2lunar(1985, 1, 21, 7))
+	assert.Equal(t, LunarDate{Year: 1990, Month: 1, Day: 1}, Solar2lunar(1990, 1, 27, 7))
+	assert.Equal(t, SolarDate{Year: 1985, Month: 1, Day: 21}, Lunar2solar(1985, 1, 1, false, 7))
+	assert.Equal(t, SolarDate{Year: 1990, Month: 1, Day: 27}, Lunar2solar(1990, 1, 1, false, 7))
+}
+
+func TestSubHourOffset(t *testing.T) {
+	for _, d := range []SolarDate{{2014, 9, 23}, {2006, 9, 12}, {1985, 1, 21}, {2024, 2, 10}} {
+		assert.Equal(t, Solar2lunar(d.Year, d.Month, d.Day, 7), Solar2lunarOffset(d.Year, d.Month, d.Day, 7*time.Hour))
+	}
+	// new moon at 23:59:53 UTC+7 is after midnight at UTC+7:06
+	offset := 7*time.Hour + 6*time.Minute
+	assert.Equal(t, LunarDate{Year: 1967, Month: 6, Day: 1}, Solar2lunar(1967, 7, 7, 7))
+	assert.Equal(t, LunarDate{Year: 1967, Month: 5, Day: 30}, Solar2lunarOffset(1967, 7, 7, offset))
+	assert.Equal(t, SolarDate{Year: 1967, Month: 7, Day: 8}, Lunar2solarOffset(1967, 6, 1, false, offset))
+
+	// India at UTC+5:30 has the same new moon dates
+	assert.Equal(t, SolarDate{Year: 2024, Month: 2, Day: 10}, Lunar2solarOffset(2024, 1, 1, false, 5*time.Hour+30*time.Minute))
+
+	c := NewCalendar(WithLocation(time.FixedZone("+0706", int(offset/time.Second))))
+	assert.Equal(t, LunarDate{Year: 1967, Month: 5, Day: 30}, c.Solar2lunar(1967, 7, 7))
+}
@@ -20,7 +20,8 @@ func GetDatesBetween(fromDate, toDate VNDate) []VNDate {
 const DefaultSolarLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
 
 // Layout uses time package layout format
-// if error occurs, VNDate with zero value is returned
+// if error occurs, VNDate with zero value is returned,
+// *RangeError if the year is not supported
 func ParseFromSolarString(dateStr, layout string) (VNDate, error) {
 	if layout == "" {
 		layout = DefaultSolarLayout
@@ -29,75 +30,71 @@ func ParseFromSolarString(dateStr, layout string) (VNDate, error) {
 	if err != nil {
 		return VNDate{}, err
 	}
-	return FromSolarTime(solarTime), nil
+	// the year in Vietnam, e.g. 3000-12-31T20:00:00Z is already 3001
+	return CheckedFromSolarTime(solarTime)
 }
 
+var dateFormatRe = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
+
+// Errors of ParseDate, ParseLunarDate and ParseSolarDate,
+// a year out of range is *RangeError
 var (
-	dateFormatRe             = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})$`)
-	errInvalidDateFormat     = errors.New("invalid date format")
-	errNotSupportedYearRange = errors.New("not supported year range")
-	errInvalidMonth          = errors.New("invalid date - month")
-	errInvalidDay            = errors.New("invalid date - day")
-	errInvalidDate           = errors.New("invalid date")
+	ErrInvalidDateFormat = errors.New("invalid date format")
+	ErrInvalidMonth      = errors.New("invalid date - month")
+	ErrInvalidDay        = errors.New("invalid date - day")
+	ErrInvalidDate       = errors.New("invalid date")
 )
 
 // ParseDate parse date string in format "YYYY-MM-DD"
 // date: string lunar date in format "YYYY-MM-DD"
-// return zero value VNDate and error if invalid format or invalid date
+// return zero value VNDate and error if invalid format or invalid date,
+// *RangeError if the year is not supported
 func ParseDate(date string) (VNDate, error) {
-	var (
-		year, day int
-		month     int
-		err       error
-	)
+	return ParseLunarDate(date, false)
+}
 
+// ParseLunarDate works as ParseDate with a date in a leap month when leap is true,
+// ErrInvalidDate if the year has no such leap month
+func ParseLunarDate(date string, leap bool) (VNDate, error) {
 	res := dateFormatRe.FindStringSubmatch(date)
-
 	if len(res) != 4 {
-		return VNDate{}, errInvalidDateFormat
+		return VNDate{}, ErrInvalidDateFormat
 	}
-	year, err = strconv.Atoi(res[1])
+	// the regexp only matches digits
+	year, _ := strconv.Atoi(res[1])
+	month, _ := strconv.Atoi(res[2])
+	day, _ := strconv.Atoi(res[3])
+	jdn, err := newConverter(defaultAstronomy(), defaultOffset()).checkedLunarJDN(year, month, day, leap)
 	if err != nil {
 		return VNDate{}, err
 	}
+	// noon UT as Date(year, month, day, 12, 0, 0, 0)
+	return FromJulianDay(JulianDay(jdn)), nil
+}
 
-	// Unsure how good the algorithm is outside this range so limit it for now
-	if 1800 > year || year > 2040 {
-		return VNDate{}, errNotSupportedYearRange
+// ParseSolarDate parses a solar date in format "YYYY-MM-DD", proleptic
+// Gregorian as time.Time, with the errors of ParseDate. The date is at
+// noon in Vietnam
+func ParseSolarDate(date string) (VNDate, error) {
+	res := dateFormatRe.FindStringSubmatch(date)
+	if len(res) != 4 {
+		return VNDate{}, ErrInvalidDateFormat
 	}
-
-	month, err = strconv.Atoi(res[2])
-	if err != nil {
+	// the regexp only matches digits
+	year, _ := strconv.Atoi(res[1])
+	month, _ := strconv.Atoi(res[2])
+	day, _ := strconv.Atoi(res[3])
+	if err := checkSolar(ProlepticGregorian, year, month, day); err != nil {
 		return VNDate{}, err
 	}
-	if 1 > month || month > 12 {
-		return VNDate{}, errInvalidMonth
-	}
-
-	day, err = strconv.Atoi(res[3])
-	if err != nil {
-		return VNDate{}, errInvalidDay
-	}
-	if 1 > day || day > 31 {
-		return VNDate{}, errInvalidDay
-	}
-
-	valid, validDate := Validate(year, month, day)
-	if !valid {
-		return VNDate{}, errInvalidDate
-	}
-
-	return validDate, nil
+	return FromSolarTime(time.Date(year, time.Month(month), day, 12, 0, 0, 0, VietNamTimeZone)), nil
 }
 
+// Validate reports whether the lunar date exists in the supported range
 func Validate(year, month, day int) (bool, VNDate) {
-	// just convert back and forth to verify date
-	testSolar := Lunar2solar(year, month, day, false, TimeZoneOffset)
-	testLunar := Solar2lunar(testSolar.Year, testSolar.Month, testSolar.Day, TimeZoneOffset)
-
-	if testLunar.Year != year || testLunar.Month != month || testLunar.Day != day {
+	jdn, err := newConverter(defaultAstronomy(), defaultOffset()).checkedLunarJDN(year, month, day, false)
+	if err != nil {
 		return false, VNDate{}
 	}
-
-	return true, Date(testSolar.Year, time.Month(testSolar.Month), testSolar.Day, 12, 0, 0, 0)
+	return true, FromJulianDay(JulianDay(jdn))
 }
@@ -63,10 +63,15 @@ func TestParseDate(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "invalid date", err.Error())
 
-	invalidDateString = "1790-08-20"
+	invalidDateString = "0999-08-20"
 	_, err = ParseDate(invalidDateString)
-	assert.Error(t, err)
-	assert.Equal(t, "not supported year range", err.Error())
+	var rangeErr *RangeError
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, 999, rangeErr.Year)
+	assert.Equal(t, "not supported year range: 999 is not in 1000-3000", err.Error())
+
+	_, err = ParseDate("1790-08-20")
+	assert.NoError(t, err)
 
 	// auto test many dates
 	startDate := time.Now()
@@ -98,3 +103,45 @@ func TestSub(t *testing.T) {
 	diff = vnDate4.Sub(vnDate3)
 	assert.Equal(t, 23*time.Hour, diff)
 }
+
+func TestParseLunarDate(t *testing.T) {
+	d, err := ParseLunarDate("2025-06-15", true)
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 6, Day: 15, Leap: true}, d.LunarDate())
+	assert.Equal(t, "2025-08-08", d.SolarTime().Format(time.DateOnly))
+
+	d, err = ParseLunarDate("2025-06-15", false)
+	assert.NoError(t, err)
+	assert.False(t, d.LunarDate().Leap)
+	assert.Equal(t, "2025-07-09", d.SolarTime().Format(time.DateOnly))
+
+	// no leap month 4 in Giáp Thìn
+	_, err = ParseLunarDate("2024-04-01", true)
+	assert.ErrorIs(t, err, ErrInvalidDate)
+	_, err = ParseLunarDate("2025-6-15", true)
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+	_, err = ParseDate("2025-13-01")
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+}
+
+func TestParseSolarDate(t *testing.T) {
+	d, err := ParseSolarDate("2025-01-29")
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 1, Day: 1}, d.LunarDate())
+	assert.Equal(t, time.Date(2025, time.January, 29, 12, 0, 0, 0, VietNamTimeZone), d.SolarTime())
+
+	// proleptic Gregorian, the days skipped by the reform exist
+	_, err = ParseSolarDate("1582-10-10")
+	assert.NoError(t, err)
+	_, err = ParseSolarDate("2025/01/29")
+	assert.ErrorIs(t, err, ErrInvalidDateFormat)
+	_, err = ParseSolarDate("2025-13-01")
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+	_, err = ParseSolarDate("2025-02-29")
+	assert.ErrorIs(t, err, ErrInvalidDay)
+	_, err = ParseSolarDate("2025-01-00")
+	assert.ErrorIs(t, err, ErrInvalidDay)
+	var rangeErr *RangeError
+	_, err = ParseSolarDate("0999-12-31")
+	assert.ErrorAs(t, err, &rangeErr)
+}
@@ -0,0 +1,129 @@
+// Package deltat provides ΔT, the difference TT - UT between
+// terrestrial time used by astronomical series and universal time
+package deltat
+
+import (
+	"math"
+	"sort"
+)
+
+// Model returns ΔT in seconds at a decimal year, e.g. 2024.5
+type Model interface {
+	Seconds(year float64) float64
+}
+
+// EspenakMeeus is the piecewise polynomial model by Espenak and Meeus,
+// used for NASA eclipse predictions. It is valid from -1999 to 3000,
+// outside that range it follows the long term parabola
+var EspenakMeeus Model = espenakMeeus{}
+
+type espenakMeeus struct{}
+
+func (espenakMeeus) Seconds(y float64) float64 {
+	var t, u float64
+	switch {
+	case y < -500:
+		u = (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u = y / 100
+		return 10583.6 - 1014.41*u + 33.78311*u*u - 5.952053*u*u*u -
+			0.1798452*math.Pow(u, 4) + 0.022174192*math.Pow(u, 5) + 0.0090316521*math.Pow(u, 6)
+	case y < 1600:
+		u = (y - 1000) / 100
+		return 1574.2 - 556.01*u + 71.23472*u*u + 0.319781*u*u*u -
+			0.8503463*math.Pow(u, 4) - 0.005050998*math.Pow(u, 5) + 0.0083572073*math.Pow(u, 6)
+	case y < 1700:
+		t = y - 1600
+		return 120 - 0.9808*t - 0.01532*t*t + t*t*t/7129
+	case y < 1800:
+		t = y - 1700
+		return 8.83 + 0.1603*t - 0.0059285*t*t + 0.00013336*t*t*t - math.Pow(t, 4)/1174000
+	case y < 1860:
+		t = y - 1800
+		return 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*math.Pow(t, 4) +
+			0.0000121272*math.Pow(t, 5) - 0.0000001699*math.Pow(t, 6) + 0.000000000875*math.Pow(t, 7)
+	case y < 1900:
+		t = y - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t -
+			0.0004473624*math.Pow(t, 4) + math.Pow(t, 5)/233174
+	case y < 1920:
+		t = y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*math.Pow(t, 4)
+	case y < 1941:
+		t = y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t = y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t = y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t = y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t +
+			0.000651814*math.Pow(t, 4) + 0.00002373599*math.Pow(t, 5)
+	case y < 2050:
+		t = y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u = (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u = (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// Entry is a known ΔT value in seconds at a decimal year
+type Entry struct {
+	Year    float64
+	Seconds float64
+}
+
+// Table interpolates linearly between entries,
+// e.g. observed values published by IERS.
+// Outside the table Fallback is used, shifted to join the first or last entry
+type Table struct {
+	entries  []Entry
+	Fallback Model
+}
+
+// NewTable returns a Table of the entries falling back to EspenakMeeus
+func NewTable(entries []Entry) *Table {
+	sorted := make([]Entry, len(entries))
+	copy(sorted, entries)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Year < sorted[j].Year
+	})
+	return &Table{entries: sorted, Fallback: EspenakMeeus}
+}
+
+func (t *Table) Seconds(year float64) float64 {
+	n := len(t.entries)
+	if n == 0 {
+		return t.Fallback.Seconds(year)
+	}
+	first, last := t.entries[0], t.entries[n-1]
+	if year <= first.Year {
+		return t.Fallback.Seconds(year) - t.Fallback.Seconds(first.Year) + first.Seconds
+	}
+	if year >= last.Year {
+		return t.Fallback.Seconds(year) - t.Fallback.Seconds(last.Year) + last.Seconds
+	}
+	i := sort.Search(n, func(i int) bool {
+		return t.entries[i].Year > year
+	})
+	a, b := t.entries[i-1], t.entries[i]
+	return a.Seconds + (b.Seconds-a.Seconds)*(year-a.Year)/(b.Year-a.Year)
+}
+
+// DecimalYear returns the decimal year of a julian day
+func DecimalYear(jd float64) float64 {
+	return 2000 + (jd-2451545)/365.25
+}
+
+// Days returns ΔT of the model in days at a julian day in UT
+func Days(m Model, jd float64) float64 {
+	return m.Seconds(DecimalYear(jd)) / 86400
+}
@@ -0,0 +1,38 @@
+package deltat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEspenakMeeus(t *testing.T) {
+	assert.InDelta(t, -2.79, EspenakMeeus.Seconds(1900), 0.01)
+	assert.InDelta(t, 63.86, EspenakMeeus.Seconds(2000), 0.01)
+	assert.InDelta(t, 1574.2, EspenakMeeus.Seconds(1000), 0.1)
+	// Meeus example 10.a, 1977-02-18 observed 48 seconds
+	assert.InDelta(t, 48, EspenakMeeus.Seconds(1977.13), 1)
+	// the polynomials join at the boundaries
+	for _, y := range []float64{1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		assert.InDelta(t, EspenakMeeus.Seconds(y-1e-6), EspenakMeeus.Seconds(y), 2, "year %v", y)
+	}
+}
+
+func TestTable(t *testing.T) {
+	table := NewTable([]Entry{{2010, 66.07}, {2000, 63.83}, {2020, 69.36}})
+	assert.Equal(t, 63.83, table.Seconds(2000))
+	assert.InDelta(t, 64.95, table.Seconds(2005), 1e-9)
+	assert.InDelta(t, 67.715, table.Seconds(2015), 1e-9)
+	assert.Equal(t, 69.36, table.Seconds(2020))
+
+	// outside the table the fallback joins the first and last entry
+	assert.InDelta(t, EspenakMeeus.Seconds(1990)-EspenakMeeus.Seconds(2000)+63.83, table.Seconds(1990), 1e-9)
+	assert.InDelta(t, EspenakMeeus.Seconds(2030)-EspenakMeeus.Seconds(2020)+69.36, table.Seconds(2030), 1e-9)
+
+	assert.Equal(t, EspenakMeeus.Seconds(2024), NewTable(nil).Seconds(2024))
+}
+
+func TestDays(t *testing.T) {
+	assert.InDelta(t, 2000.0, DecimalYear(2451545), 1e-9)
+	assert.InDelta(t, 63.86/86400, Days(EspenakMeeus, 2451545), 1e-9)
+}
@@ -0,0 +1,256 @@
+// Package eclipse predicts solar and lunar eclipses based on
+// Jean Meeus, Astronomical Algorithms, 2nd edition, chapter 54,
+// and tells whether they are visible from Vietnam
+package eclipse
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/moon"
+	"github.com/vanng822/vncalendar/place"
+)
+
+// Kind tells whether the sun or the moon is eclipsed
+type Kind int
+
+const (
+	// Solar eclipse (nhật thực) at a new moon
+	Solar Kind = iota
+	// Lunar eclipse (nguyệt thực) at a full moon
+	Lunar
+)
+
+var kindNames = []string{"Nhật thực", "Nguyệt thực"}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return kindNames[k]
+}
+
+// Type of an eclipse
+type Type int
+
+const (
+	Partial Type = iota
+	Total
+	// Annular is a central solar eclipse with the moon too small
+	// to cover the sun
+	Annular
+	// Hybrid is a central solar eclipse which is annular along
+	// part of the path and total along the rest
+	Hybrid
+	// Penumbral is a lunar eclipse where the moon only enters
+	// the penumbra of the Earth
+	Penumbral
+)
+
+var typeNames = []string{"một phần", "toàn phần", "hình khuyên", "lai", "nửa tối"}
+
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return fmt.Sprintf("Type(%d)", int(t))
+	}
+	return typeNames[t]
+}
+
+// Eclipse is a solar or lunar eclipse
+type Eclipse struct {
+	Kind Kind
+	Type Type
+	// Lunation is the moon.Lunation of the new moon of the eclipse,
+	// a lunar eclipse is at the full moon of the lunation
+	Lunation int
+	// Greatest is the time of greatest eclipse, for a solar eclipse
+	// when the axis of the moon's shadow is closest to the Earth's center
+	Greatest time.Time
+	// Gamma is the least distance from the axis of the moon's shadow,
+	// or the moon's center, to the center of the Earth's shadow
+	// in units of the Earth's equatorial radius
+	Gamma float64
+	// Magnitude is the fraction of the diameter covered at greatest
+	// eclipse, of the sun for a partial solar eclipse and of the moon
+	// by the umbra, or the penumbra for a penumbral eclipse.
+	// It is 0 for central solar eclipses where it depends on the place
+	Magnitude float64
+	// Duration is the length of the partial phase of a lunar eclipse,
+	// or the penumbral phase for a penumbral eclipse,
+	// 0 for a solar eclipse
+	Duration time.Duration
+	// Totality is the length of the total phase of a lunar eclipse
+	Totality time.Duration
+	// Visible tells whether the eclipse is seen from any of place.Cities
+	Visible bool
+}
+
+// Date returns the date of greatest eclipse in Vietnam,
+// mùng 1 for a solar eclipse and around rằm for a lunar one.
+// It returns *vncalendar.RangeError if the date is not supported
+func (e Eclipse) Date() (vncalendar.VNDate, error) {
+	return vncalendar.CheckedFromSolarTime(e.Greatest)
+}
+
+// VisibleFrom tells whether any part of the eclipse is seen from the place,
+// the eclipsed body being above the horizon during the eclipse
+func (e Eclipse) VisibleFrom(p place.Place) bool {
+	if e.Kind == Lunar {
+		return lunarVisible(e, p)
+	}
+	return solarVisible(e, p)
+}
+
+func (e Eclipse) visibleInVietnam() bool {
+	for _, p := range place.Cities {
+		if e.VisibleFrom(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// SolarEclipse returns the solar eclipse at the new moon of lunation k,
+// false if there is none
+func SolarEclipse(k int) (Eclipse, bool) {
+	return eclipseAt(k, Solar)
+}
+
+// LunarEclipse returns the lunar eclipse at the full moon of lunation k,
+// false if there is none
+func LunarEclipse(k int) (Eclipse, bool) {
+	return eclipseAt(k, Lunar)
+}
+
+// Between returns solar and lunar eclipses with greatest eclipse in [from, to)
+// in time order, times are in the location of from
+func Between(from, to time.Time) []Eclipse {
+	var eclipses []Eclipse
+	for k := moon.Lunation(from, deltat.EspenakMeeus) - 1; ; k++ {
+		for _, kind := range []Kind{Solar, Lunar} {
+			e, ok := eclipseAt(k, kind)
+			if !ok {
+				continue
+			}
+			if !e.Greatest.Before(to) {
+				return eclipses
+			}
+			if !e.Greatest.Before(from) {
+				e.Greatest = e.Greatest.In(from.Location())
+				eclipses = append(eclipses, e)
+			}
+		}
+		// stop when the lunation is past the range
+		if moon.PhaseTime(k, moon.NewMoon, deltat.EspenakMeeus).After(to) {
+			return eclipses
+		}
+	}
+}
+
+const dr = math.Pi / 180
+
+// eclipseAt computes the eclipse at the new or full moon of lunation k, Meeus chapter 54
+func eclipseAt(lunation int, kind Kind) (Eclipse, bool) {
+	k := float64(lunation)
+	if kind == Lunar {
+		k += 0.5
+	}
+	T := k / 1236.85
+	T2, T3, T4 := T*T, T*T*T, T*T*T*T
+	F := (160.7108 + 390.67050284*k - 0.0016118*T2 - 0.00000227*T3 + 0.000000011*T4) * dr
+	if math.Abs(math.Sin(F)) > 0.36 {
+		return Eclipse{}, false
+	}
+	jde := 2451550.09766 + moon.SynodicMonth*k + 0.00015437*T2 - 0.000000150*T3 + 0.00000000073*T4
+	E := 1 - 0.002516*T - 0.0000074*T2
+	M := (2.5534 + 29.10535670*k - 0.0000014*T2 - 0.00000011*T3) * dr
+	mPr := (201.5643 + 385.81693528*k + 0.0107582*T2 + 0.00001238*T3 - 0.000000058*T4) * dr
+	omega := (124.7746 - 1.56375588*k + 0.0020672*T2 + 0.00000215*T3) * dr
+	F1 := F - 0.02665*dr*math.Sin(omega)
+	A1 := (299.77 + 0.107408*k - 0.009173*T2) * dr
+
+	if kind == Solar {
+		jde += -0.4075*math.Sin(mPr) + 0.1721*E*math.Sin(M)
+	} else {
+		jde += -0.4065*math.Sin(mPr) + 0.1727*E*math.Sin(M)
+	}
+	jde += 0.0161*math.Sin(2*mPr) - 0.0097*math.Sin(2*F1) +
+		0.0073*E*math.Sin(mPr-M) - 0.0050*E*math.Sin(mPr+M) -
+		0.0023*math.Sin(mPr-2*F1) + 0.0021*E*math.Sin(2*M) +
+		0.0012*math.Sin(mPr+2*F1) + 0.0006*E*math.Sin(2*mPr+M) -
+		0.0004*math.Sin(3*mPr) - 0.0003*E*math.Sin(M+2*F1) +
+		0.0003*math.Sin(A1) - 0.0002*E*math.Sin(M-2*F1) -
+		0.0002*E*math.Sin(2*mPr-M) - 0.0002*math.Sin(omega)
+
+	P := 0.2070*E*math.Sin(M) + 0.0024*E*math.Sin(2*M) - 0.0392*math.Sin(mPr) +
+		0.0116*math.Sin(2*mPr) - 0.0073*E*math.Sin(mPr+M) + 0.0067*E*math.Sin(mPr-M) +
+		0.0118*math.Sin(2*F1)
+	Q := 5.2207 - 0.0048*E*math.Cos(M) + 0.0020*E*math.Cos(2*M) - 0.3299*math.Cos(mPr) -
+		0.0060*E*math.Cos(mPr+M) + 0.0041*E*math.Cos(mPr-M)
+	W := math.Abs(math.Cos(F1))
+	gamma := (P*math.Cos(F1) + Q*math.Sin(F1)) * (1 - 0.0048*W)
+	u := 0.0059 + 0.0046*E*math.Cos(M) - 0.0182*math.Cos(mPr) +
+		0.0004*math.Cos(2*mPr) - 0.0005*math.Cos(M+mPr)
+
+	e := Eclipse{
+		Kind:     kind,
+		Lunation: lunation,
+		Greatest: vncalendar.JulianDay(jde - deltat.Days(deltat.EspenakMeeus, jde)).Time(),
+		Gamma:    gamma,
+	}
+	absGamma := math.Abs(gamma)
+	if kind == Solar {
+		if absGamma > 1.5433+u {
+			return Eclipse{}, false
+		}
+		switch {
+		case absGamma > 0.9972:
+			// non-central eclipses are partial for almost every place
+			e.Type = Partial
+			e.Magnitude = (1.5433 + u - absGamma) / (0.5461 + 2*u)
+		case u < 0:
+			e.Type = Total
+		case u > 0.0047:
+			e.Type = Annular
+		case u < 0.00464*math.Sqrt(1-gamma*gamma):
+			e.Type = Hybrid
+		default:
+			e.Type = Annular
+		}
+	} else {
+		penumbral := (1.5573 + u - absGamma) / 0.5450
+		umbral := (1.0128 - u - absGamma) / 0.5450
+		if penumbral <= 0 {
+			return Eclipse{}, false
+		}
+		// semidurations in minutes
+		n := 0.5458 + 0.0400*math.Cos(mPr)
+		semiduration := func(radius float64) time.Duration {
+			if radius <= absGamma {
+				return 0
+			}
+			minutes := 60 / n * math.Sqrt(radius*radius-gamma*gamma)
+			return time.Duration(2 * minutes * float64(time.Minute)).Round(time.Second)
+		}
+		switch {
+		case umbral <= 0:
+			e.Type = Penumbral
+			e.Magnitude = penumbral
+			e.Duration = semiduration(1.5573 + u)
+		case umbral < 1:
+			e.Type = Partial
+			e.Magnitude = umbral
+			e.Duration = semiduration(1.0128 - u)
+		default:
+			e.Type = Total
+			e.Magnitude = umbral
+			e.Duration = semiduration(1.0128 - u)
+			e.Totality = semiduration(0.4678 - u)
+		}
+	}
+	e.Visible = e.visibleInVietnam()
+	return e, true
+}
@@ -0,0 +1,111 @@
+package eclipse
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/place"
+)
+
+func TestSolarEclipse(t *testing.T) {
+	// Meeus example 54.a, 1993 May 21
+	e, ok := SolarEclipse(-82)
+	assert.True(t, ok)
+	assert.Equal(t, Solar, e.Kind)
+	assert.Equal(t, Partial, e.Type)
+	assert.InDelta(t, 1.1348, e.Gamma, 0.0001)
+	assert.InDelta(t, 0.740, e.Magnitude, 0.001)
+	// JDE 2449129.0979
+	assert.WithinDuration(t, time.Date(1993, time.May, 21, 14, 19, 54, 0, time.UTC), e.Greatest, time.Minute)
+
+	_, ok = SolarEclipse(-81)
+	assert.False(t, ok)
+}
+
+func TestLunarEclipse(t *testing.T) {
+	// total lunar eclipse of 2025 September 7, greatest at 18:12 UT,
+	// partial phase 3h29m, totality 82 minutes
+	e, ok := LunarEclipse(317)
+	assert.True(t, ok)
+	assert.Equal(t, Lunar, e.Kind)
+	assert.Equal(t, Total, e.Type)
+	assert.WithinDuration(t, time.Date(2025, time.September, 7, 18, 12, 0, 0, time.UTC), e.Greatest, 2*time.Minute)
+	assert.InDelta(t, 1.36, e.Magnitude, 0.01)
+	assert.InDelta(t, 209, e.Duration.Minutes(), 2)
+	assert.InDelta(t, 82, e.Totality.Minutes(), 2)
+	assert.True(t, e.Visible)
+	// rằm tháng Bảy Ất Tỵ
+	d, err := e.Date()
+	require.NoError(t, err)
+	assert.Equal(t, vncalendar.LunarDate{Year: 2025, Month: 7, Day: 17}, d.LunarDate())
+
+	var rangeErr *vncalendar.RangeError
+	e, ok = SolarEclipse(-12500)
+	require.True(t, ok)
+	_, err = e.Date()
+	assert.ErrorAs(t, err, &rangeErr)
+}
+
+func TestBetween(t *testing.T) {
+	from := time.Date(2023, time.January, 1, 0, 0, 0, 0, vncalendar.VietNamTimeZone)
+	to := time.Date(2025, time.January, 1, 0, 0, 0, 0, vncalendar.VietNamTimeZone)
+	eclipses := Between(from, to)
+	type summary struct {
+		Kind    Kind
+		Type    Type
+		Day     string
+		Visible bool
+	}
+	var got []summary
+	for _, e := range eclipses {
+		assert.Equal(t, vncalendar.VietNamTimeZone, e.Greatest.Location())
+		got = append(got, summary{e.Kind, e.Type, e.Greatest.Format(time.DateOnly), e.Visible})
+	}
+	assert.Equal(t, []summary{
+		{Solar, Hybrid, "2023-04-20", true},
+		{Lunar, Penumbral, "2023-05-06", true},
+		{Solar, Annular, "2023-10-15", false},
+		{Lunar, Partial, "2023-10-29", true},
+		{Lunar, Penumbral, "2024-03-25", false},
+		{Solar, Total, "2024-04-09", false},
+		{Lunar, Partial, "2024-09-18", false},
+		{Solar, Annular, "2024-10-03", false},
+	}, got)
+
+	// solar eclipses are on mùng 1
+	for _, e := range eclipses {
+		if e.Kind == Solar {
+			d, err := e.Date()
+			require.NoError(t, err)
+			assert.Equal(t, 1, d.Day())
+		}
+	}
+}
+
+func TestVisibleFrom(t *testing.T) {
+	// annular eclipse of 2020 June 21 was partial in all of Vietnam
+	e, ok := SolarEclipse(253)
+	assert.True(t, ok)
+	assert.Equal(t, Annular, e.Type)
+	for _, p := range place.Cities {
+		assert.True(t, e.VisibleFrom(p), p.Name)
+	}
+	// but not in the southern hemisphere far from the path
+	assert.False(t, e.VisibleFrom(place.Place{Name: "Sydney", Latitude: -33.87, Longitude: 151.21}))
+
+	// total lunar eclipse of 2022 November 8 rose eclipsed in Vietnam
+	e, ok = LunarEclipse(282)
+	assert.True(t, ok)
+	assert.True(t, e.VisibleFrom(place.HaNoi))
+	assert.False(t, e.VisibleFrom(place.Place{Name: "Paris", Latitude: 48.86, Longitude: 2.35}))
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "Nguyệt thực", Lunar.String())
+	assert.Equal(t, "hình khuyên", Annular.String())
+	assert.Equal(t, "Kind(2)", Kind(2).String())
+	assert.Equal(t, "Type(-1)", Type(-1).String())
+}
@@ -0,0 +1,92 @@
+package eclipse
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/internal/astro"
+	"github.com/vanng822/vncalendar/place"
+)
+
+// visibilityStep is the sampling interval when checking visibility
+const visibilityStep = 5 * time.Minute
+
+// solarSpan is the longest time the penumbra of the moon
+// takes to cross the Earth, on each side of greatest eclipse
+const solarSpan = 4 * time.Hour
+
+// lunarVisible tells whether the moon is above the horizon of the place
+// at some time during the eclipse
+func lunarVisible(e Eclipse, p place.Place) bool {
+	half := e.Duration / 2
+	for d := -half; d <= half; d += visibilityStep {
+		ra, dec, distance := astro.MoonEquatorial(jde(e.Greatest.Add(d)))
+		jd := float64(vncalendar.JulianDayOf(e.Greatest.Add(d)))
+		h := altitude(jd, p, ra, dec)
+		if h-astro.MoonParallax(distance)*math.Cos(h*dr) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// solarVisible tells whether the sun is above the horizon of the place
+// while the moon, seen from there, overlaps it
+func solarVisible(e Eclipse, p place.Place) bool {
+	for d := -solarSpan; d <= solarSpan; d += visibilityStep {
+		t := e.Greatest.Add(d)
+		jd := float64(vncalendar.JulianDayOf(t))
+		sunRA, sunDec := astro.SunEquatorial(jde(t))
+		if altitude(jd, p, sunRA, sunDec) <= 0 {
+			continue
+		}
+		moonRA, moonDec, distance := astro.MoonEquatorial(jde(t))
+		moonRA, moonDec = topocentric(jd, p, moonRA, moonDec, distance)
+		_, _, r := astro.EarthHeliocentric(jde(t))
+		// semi-diameters in degrees, Meeus chapters 55 and 47
+		sunRadius := 959.63 / 3600 / r
+		moonRadius := math.Asin(1737.4/distance) / dr
+		if separation(sunRA, sunDec, moonRA, moonDec) < sunRadius+moonRadius {
+			return true
+		}
+	}
+	return false
+}
+
+func jde(t time.Time) float64 {
+	jd := float64(vncalendar.JulianDayOf(t))
+	return jd + deltat.Days(deltat.EspenakMeeus, jd)
+}
+
+// altitude returns geocentric altitude in degrees of a body
+// at the julian day in UT
+func altitude(jd float64, p place.Place, ra, dec float64) float64 {
+	hourAngle := astro.SiderealTime(jd) + p.Longitude - ra
+	sinLat, cosLat := math.Sincos(p.Latitude * dr)
+	sinDec, cosDec := math.Sincos(dec * dr)
+	return math.Asin(sinLat*sinDec+cosLat*cosDec*math.Cos(hourAngle*dr)) / dr
+}
+
+// topocentric corrects right ascension and declination of the moon
+// for parallax seen from the place at sea level, Meeus chapter 40
+func topocentric(jd float64, p place.Place, ra, dec, distance float64) (float64, float64) {
+	const flattening = 0.99664719
+	u := math.Atan(flattening * math.Tan(p.Latitude*dr))
+	rhoSin, rhoCos := flattening*math.Sin(u), math.Cos(u)
+	sinParallax := astro.EarthRadius / distance
+	hourAngle := (astro.SiderealTime(jd) + p.Longitude - ra) * dr
+	sinDec, cosDec := math.Sincos(dec * dr)
+	deltaRA := math.Atan2(-rhoCos*sinParallax*math.Sin(hourAngle), cosDec-rhoCos*sinParallax*math.Cos(hourAngle))
+	topoDec := math.Atan2((sinDec-rhoSin*sinParallax)*math.Cos(deltaRA), cosDec-rhoCos*sinParallax*math.Cos(hourAngle))
+	return ra + deltaRA/dr, topoDec / dr
+}
+
+// separation returns the angle in degrees between two positions
+func separation(ra1, dec1, ra2, dec2 float64) float64 {
+	sin1, cos1 := math.Sincos(dec1 * dr)
+	sin2, cos2 := math.Sincos(dec2 * dr)
+	c := sin1*sin2 + cos1*cos2*math.Cos((ra1-ra2)*dr)
+	return math.Acos(math.Min(1, c)) / dr
+}
@@ -0,0 +1,86 @@
+package vncalendar
+
+import (
+	"math"
+
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/internal/astro"
+	"github.com/vanng822/vncalendar/moon"
+)
+
+// Engine selects the astronomical algorithms for new moons and sun longitude
+type Engine int
+
+const (
+	// StandardEngine uses the short series of the original algorithm,
+	// new moons are accurate to a few minutes
+	StandardEngine Engine = iota
+	// PrecisionEngine uses all corrections of Meeus chapter 49 for new moons
+	// and truncated VSOP87 for the sun longitude.
+	// It is slower and may differ from printed calendars on borderline days
+	PrecisionEngine
+)
+
+func (e Engine) astronomy(deltaT deltat.Model) astronomy {
+	if e == PrecisionEngine {
+		return precisionAstronomy{deltaT: deltaT}
+	}
+	return standardAstronomy{deltaT: deltaT}
+}
+
+// defaultAstronomy is used by package level functions
+func defaultAstronomy() astronomy {
+	return StandardEngine.astronomy(deltat.EspenakMeeus)
+}
+
+type astronomy interface {
+	// newMoon returns julian day in UT of the k-th new moon after 1900-01-01
+	newMoon(k int) float64
+	// sunLongitude returns sun longitude in radians at julian day in UT,
+	// used for lunar months
+	sunLongitude(jd float64) float64
+	// apparentSunLongitude returns the apparent sun longitude in degrees
+	// at julian day in UT, used for exact solar terms
+	apparentSunLongitude(jd float64) float64
+}
+
+type standardAstronomy struct {
+	deltaT deltat.Model
+}
+
+func (a standardAstronomy) newMoon(k int) float64 {
+	jde := newMoon(k)
+	return jde - deltat.Days(a.deltaT, jde)
+}
+
+func (a standardAstronomy) sunLongitude(jd float64) float64 {
+	return sunLongitude(jd + deltat.Days(a.deltaT, jd))
+}
+
+// apparentSunLongitude applies aberration and nutation to the true longitude,
+// without them solar terms start about 10 minutes early
+func (a standardAstronomy) apparentSunLongitude(jd float64) float64 {
+	T := (jd - 2451545.0) / 36525.0
+	omega := (125.04 - 1934.136*T) * math.Pi / 180
+	return math.Mod(a.sunLongitude(jd)*180/math.Pi-0.00569-0.00478*math.Sin(omega)+360, 360)
+}
+
+type precisionAstronomy struct {
+	deltaT deltat.Model
+}
+
+// k of moon package is 0 at the new moon of 2000-01-06
+const moonLunationOffset = 1237
+
+func (a precisionAstronomy) newMoon(k int) float64 {
+	jde := moon.PhaseJDE(k-moonLunationOffset, moon.NewMoon)
+	return jde - deltat.Days(a.deltaT, jde)
+}
+
+func (a precisionAstronomy) sunLongitude(jd float64) float64 {
+	return a.apparentSunLongitude(jd) * math.Pi / 180
+}
+
+func (a precisionAstronomy) apparentSunLongitude(jd float64) float64 {
+	return astro.SunApparentLongitude(jd + deltat.Days(a.deltaT, jd))
+}
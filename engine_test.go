@@ -0,0 +1,104 @@
+package vncalendar
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+)
+
+// lunations from 1800 to 2100
+const (
+	firstLunation = -1237
+	lastLunation  = 2478
+)
+
+func TestEnginesNewMoon(t *testing.T) {
+	standard, precision := defaultAstronomy(), PrecisionEngine.astronomy(deltat.EspenakMeeus)
+	for k := firstLunation; k <= lastLunation; k++ {
+		diff := math.Abs(standard.newMoon(k)-precision.newMoon(k)) * 24 * 60
+		assert.Less(t, diff, 5.0, "new moon %d differs %.1f minutes", k, diff)
+	}
+}
+
+func TestEnginesSunLongitude(t *testing.T) {
+	standard, precision := defaultAstronomy(), PrecisionEngine.astronomy(deltat.EspenakMeeus)
+	for jd := float64(jdFromDate(1, 1, 1800)); jd < float64(jdFromDate(1, 1, 2101)); jd += 10 {
+		diff := math.Abs(standard.apparentSunLongitude(jd) - precision.apparentSunLongitude(jd))
+		diff = math.Min(diff, 360-diff)
+		assert.Less(t, diff, 0.015, "sun longitude at %f differs %f degrees", jd, diff)
+	}
+}
+
+// TestEnginesBorderlineDays flags new moons where the engines disagree on
+// the day of the new moon or on the solar term sector at its midnight,
+// these are the only places where conversions differ
+func TestEnginesBorderlineDays(t *testing.T) {
+	standard := newConverter(defaultAstronomy(), defaultOffset())
+	precision := newConverter(PrecisionEngine.astronomy(deltat.EspenakMeeus), defaultOffset())
+	minutesToMidnight := func(jd float64) float64 {
+		local := jd + 0.5 + float64(TimeZoneOffset)/24
+		frac := local - math.Floor(local)
+		return math.Min(frac, 1-frac) * 24 * 60
+	}
+	for k := firstLunation; k <= lastLunation; k++ {
+		day := standard.getNewMoonDay(k)
+		if day != precision.getNewMoonDay(k) {
+			minutes := minutesToMidnight(standard.astronomy.newMoon(k))
+			t.Logf("borderline new moon %v: %.1f minutes from midnight", jdToDate(day), minutes)
+			assert.Less(t, minutes, 5.0)
+			continue
+		}
+		if standard.getSunLongitude(day) != precision.getSunLongitude(day) {
+			jd := float64(day) - 0.5 - float64(TimeZoneOffset)/24
+			longitude := standard.astronomy.apparentSunLongitude(jd)
+			degrees := math.Abs(longitude - math.Round(longitude/30)*30)
+			t.Logf("borderline sun longitude on %v: %.4f degrees from a major term", jdToDate(day), degrees)
+			assert.Less(t, degrees, 0.01)
+		}
+	}
+}
+
+func TestCalendarWithPrecisionEngine(t *testing.T) {
+	c := NewCalendar(WithEngine(PrecisionEngine))
+	assert.Equal(t, LunarDate{Year: 2014, Month: 8, Day: 30}, c.Solar2lunar(2014, 9, 23))
+	assert.Equal(t, LunarDate{Year: 2006, Month: 7, Day: 20, Leap: true}, c.Solar2lunar(2006, 9, 12))
+	assert.Equal(t, SolarDate{Year: 2012, Month: 6, Day: 12}, c.Lunar2solar(2012, 4, 23, true))
+
+	d := c.FromSolarTime(time.Date(2024, time.February, 10, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 1}, d.LunarDate())
+	assert.Equal(t, d.LunarDate(), d.NextDay().PreviousDay().LunarDate())
+
+	// new moon close to midnight of 2072-12-09 in Vietnam,
+	// both engines agree once ΔT is applied to the standard series
+	assert.Equal(t, LunarDate{Year: 2072, Month: 11, Day: 1}, Solar2lunar(2072, 12, 9, TimeZoneOffset))
+	assert.Equal(t, LunarDate{Year: 2072, Month: 11, Day: 1}, c.Solar2lunar(2072, 12, 9))
+}
+
+func TestCalendarSolarTermsPrecision(t *testing.T) {
+	terms := NewCalendar(WithEngine(PrecisionEngine)).SolarTerms(2024)
+	expected := map[SolarTerm]time.Time{
+		LapXuan:  time.Date(2024, time.February, 4, 8, 27, 0, 0, time.UTC),
+		XuanPhan: time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC),
+		HaChi:    time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC),
+		ThuPhan:  time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC),
+		DongChi:  time.Date(2024, time.December, 21, 9, 21, 0, 0, time.UTC),
+	}
+	for _, e := range terms {
+		if want, ok := expected[e.Term]; ok {
+			assert.WithinDuration(t, want, e.Time, 2*time.Minute, e.Term.String())
+		}
+	}
+}
+
+func TestEnginesSupportedRange(t *testing.T) {
+	standard, precision := defaultAstronomy(), PrecisionEngine.astronomy(deltat.EspenakMeeus)
+	first := int(math.Floor((float64(jdFromDate(1, 1, MinSupportedYear)) - 2415021) / 29.530588853))
+	last := int(math.Ceil((float64(jdFromDate(1, 1, MaxSupportedYear+1)) - 2415021) / 29.530588853))
+	for k := first; k <= last; k += 7 {
+		diff := math.Abs(standard.newMoon(k)-precision.newMoon(k)) * 24 * 60
+		assert.Less(t, diff, 10.0, "new moon %d differs %.1f minutes", k, diff)
+	}
+}
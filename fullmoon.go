@@ -0,0 +1,50 @@
+package vncalendar
+
+import (
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/moon"
+)
+
+// FullMoonDate returns the date with the exact time of the astronomical
+// full moon in the given lunar month in Vietnam time zone.
+// Its lunar day is not always 15 (rằm) but can be from 14 to 17
+func FullMoonDate(lunarYear, lunarMonth int, lunarLeap bool) (VNDate, error) {
+	first, err := newConverter(defaultAstronomy(), defaultOffset()).checkedLunarJDN(lunarYear, lunarMonth, 1, lunarLeap)
+	if err != nil {
+		return VNDate{}, err
+	}
+	return FromSolarTime(moon.PhaseTime(lunationOf(first), moon.FullMoon, deltat.EspenakMeeus)), nil
+}
+
+// lunationOf returns the lunation of the new moon starting the lunar month
+// with the given first day as julian day number. Mùng 8 is used since the new moon
+// of moon package may differ a few minutes and fall on the day before mùng 1
+func lunationOf(first int) int {
+	return moon.Lunation(JulianDay(first+7).Time(), deltat.EspenakMeeus)
+}
+
+// FullMoonDiscrepancy is a lunar month where the full moon is not on rằm
+type FullMoonDiscrepancy struct {
+	Month LunarDate
+	// FullMoon is the exact time of the full moon,
+	// its Day() is 14, 16 or 17
+	FullMoon VNDate
+}
+
+// FullMoonDiscrepancies lists lunar months from fromYear to toYear,
+// both included, where the full moon does not fall on rằm
+func FullMoonDiscrepancies(fromYear, toYear int) []FullMoonDiscrepancy {
+	var discrepancies []FullMoonDiscrepancy
+	c := newConverter(defaultAstronomy(), defaultOffset())
+	from := lunationOf(c.lunarJDN(fromYear, 1, 1, false))
+	to := lunationOf(c.lunarJDN(toYear+1, 1, 1, false))
+	for k := from; k < to; k++ {
+		fullMoon := FromSolarTime(moon.PhaseTime(k, moon.FullMoon, deltat.EspenakMeeus))
+		if fullMoon.Day() != 15 {
+			month := fullMoon.LunarDate()
+			month.Day = 1
+			discrepancies = append(discrepancies, FullMoonDiscrepancy{Month: month, FullMoon: fullMoon})
+		}
+	}
+	return discrepancies
+}
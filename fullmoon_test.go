@@ -0,0 +1,41 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFullMoonDate(t *testing.T) {
+	d, err := FullMoonDate(2024, 1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 15}, d.LunarDate())
+	assert.WithinDuration(t, time.Date(2024, time.February, 24, 19, 30, 0, 0, VietNamTimeZone), d.SolarTime(), 2*time.Minute)
+
+	d, err = FullMoonDate(2024, 2, false)
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2024, Month: 2, Day: 16}, d.LunarDate())
+
+	// leap month 4 of 2012
+	d, err = FullMoonDate(2012, 4, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, int(d.Month()))
+	assert.True(t, d.LunarDate().Leap)
+
+	_, err = FullMoonDate(2024, 4, true)
+	assert.Error(t, err)
+}
+
+func TestFullMoonDiscrepancies(t *testing.T) {
+	discrepancies := FullMoonDiscrepancies(2024, 2025)
+	assert.NotEmpty(t, discrepancies)
+	assert.Equal(t, LunarDate{Year: 2024, Month: 2, Day: 1}, discrepancies[0].Month)
+	for _, d := range discrepancies {
+		day := d.FullMoon.Day()
+		assert.True(t, day == 14 || day == 16 || day == 17)
+		assert.True(t, d.Month.Year == 2024 || d.Month.Year == 2025)
+		assert.Equal(t, d.Month.Month, int(d.FullMoon.Month()))
+		assert.Equal(t, d.Month.Leap, d.FullMoon.LunarDate().Leap)
+	}
+}
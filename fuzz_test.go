@@ -0,0 +1,122 @@
+package vncalendar
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	firstSupportedDay = jdFromDate(1, 1, MinSupportedYear)
+	lastSupportedDay  = jdFromDate(31, 12, MaxSupportedYear)
+)
+
+// supportedDay maps any int to a julian day number in the supported range
+func supportedDay(n int) int {
+	return firstSupportedDay + mod(n, lastSupportedDay-firstSupportedDay)
+}
+
+// nextLunarDay reports whether b is the lunar day after a
+func nextLunarDay(a, b LunarDate) bool {
+	if b.Day == a.Day+1 {
+		return a.Year == b.Year && a.Month == b.Month && a.Leap == b.Leap
+	}
+	if b.Day != 1 || (a.Day != 29 && a.Day != 30) {
+		return false
+	}
+	switch {
+	case b.Leap:
+		// leap month repeats the month number
+		return !a.Leap && a.Month == b.Month && a.Year == b.Year
+	case b.Month == 1:
+		return a.Month == 12 && a.Year+1 == b.Year
+	default:
+		return a.Month+1 == b.Month && a.Year == b.Year
+	}
+}
+
+func checkSolarDay(t *testing.T, jd int) {
+	solar := jdToDate(jd)
+	lunar := Solar2lunar(solar.Year, solar.Month, solar.Day, TimeZoneOffset)
+	assert.Equal(t, solar, Lunar2solar(lunar.Year, lunar.Month, lunar.Day, lunar.Leap, TimeZoneOffset), "%v %v", solar, lunar)
+
+	next := jdToDate(jd + 1)
+	nextLunar := Solar2lunar(next.Year, next.Month, next.Day, TimeZoneOffset)
+	assert.True(t, nextLunarDay(lunar, nextLunar), "%v %v then %v %v", solar, lunar, next, nextLunar)
+}
+
+func FuzzSolar2lunar(f *testing.F) {
+	seeds := []SolarDate{
+		// Tết and the last day of lunar years
+		{2024, 2, 10}, {2024, 2, 9}, {1985, 1, 21}, {2007, 2, 17},
+		// leap months 2 of 2023, 4 of 2020, 11 of 2033 and 12 of 1403
+		{2023, 3, 22}, {2023, 4, 19}, {2020, 5, 23}, {2033, 12, 22}, {1404, 1, 13},
+		// solar year boundaries and the Gregorian switch
+		{1999, 12, 31}, {2000, 1, 1}, {1582, 10, 4}, {1582, 10, 15},
+		// edges of the supported range
+		{MinSupportedYear, 1, 1}, {MaxSupportedYear, 12, 30},
+	}
+	for _, s := range seeds {
+		f.Add(jdFromDate(s.Day, s.Month, s.Year) - firstSupportedDay)
+	}
+	f.Fuzz(func(t *testing.T, n int) {
+		checkSolarDay(t, supportedDay(n))
+	})
+}
+
+func FuzzParseDate(f *testing.F) {
+	for _, s := range []string{
+		"2024-01-01", "2023-12-30", "2023-02-29", "2020-04-30", "2033-11-29",
+		"1000-01-01", "3000-12-29", "0999-12-01", "2025-13-01", "2025-08-32", "2025-8-1",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		d, err := ParseDate(s)
+		if err != nil {
+			return
+		}
+		assert.Equal(t, s, d.Format(""))
+		again, err := ParseDate(d.Format(""))
+		assert.NoError(t, err)
+		assert.Equal(t, d.LunarDate(), again.LunarDate())
+	})
+}
+
+func TestConsecutiveDays(t *testing.T) {
+	if testing.Short() {
+		t.Skip("checks every day of the supported range")
+	}
+	previous := jdToDate(firstSupportedDay)
+	previousLunar := Solar2lunar(previous.Year, previous.Month, previous.Day, TimeZoneOffset)
+	for jd := firstSupportedDay + 1; jd <= lastSupportedDay; jd++ {
+		solar := jdToDate(jd)
+		lunar := Solar2lunar(solar.Year, solar.Month, solar.Day, TimeZoneOffset)
+		if !assert.True(t, nextLunarDay(previousLunar, lunar), "%v %v then %v %v", previous, previousLunar, solar, lunar) {
+			return
+		}
+		previous, previousLunar = solar, lunar
+	}
+}
+
+func TestMonthLengths(t *testing.T) {
+	for year := MinSupportedYear; year < MaxSupportedYear; year++ {
+		first := Lunar2solar(year, 1, 1, false, TimeZoneOffset)
+		next := Lunar2solar(year+1, 1, 1, false, TimeZoneOffset)
+		jd, end := jdFromDate(first.Day, first.Month, first.Year), jdFromDate(next.Day, next.Month, next.Year)
+		months := 0
+		for jd < end {
+			d := jdToDate(jd)
+			assert.Equal(t, 1, Solar2lunar(d.Year, d.Month, d.Day, TimeZoneOffset).Day, "%v", d)
+			// the month has 30 days if day 30 follows day 29
+			length := 29
+			if d30 := jdToDate(jd + 29); Solar2lunar(d30.Year, d30.Month, d30.Day, TimeZoneOffset).Day == 30 {
+				length = 30
+			}
+			jd += length
+			months++
+		}
+		assert.Equal(t, end, jd)
+		assert.True(t, months == 12 || months == 13, "%d has %d months", year, months)
+	}
+}
@@ -0,0 +1,125 @@
+package vncalendar
+
+import (
+	"sort"
+	"time"
+)
+
+// Holiday is a Vietnamese festival or public holiday
+// recurring on a lunar or a solar date every year
+type Holiday struct {
+	Name string
+	// Lunar tells whether Month and Day are a lunar date,
+	// lunar holidays are never in a leap month
+	Lunar      bool
+	Month, Day int
+	// Public tells whether it is a day off by the Labour Code
+	Public bool
+}
+
+// Holidays are the festivals and public holidays in the order of the year
+var Holidays = []Holiday{
+	{Name: "Tết Dương lịch", Month: 1, Day: 1, Public: true},
+	{Name: "Tết Nguyên Đán", Lunar: true, Month: 1, Day: 1, Public: true},
+	{Name: "Mùng 2 Tết", Lunar: true, Month: 1, Day: 2, Public: true},
+	{Name: "Mùng 3 Tết", Lunar: true, Month: 1, Day: 3, Public: true},
+	{Name: "Tết Nguyên Tiêu", Lunar: true, Month: 1, Day: 15},
+	{Name: "Tết Hàn Thực", Lunar: true, Month: 3, Day: 3},
+	{Name: "Giỗ Tổ Hùng Vương", Lunar: true, Month: 3, Day: 10, Public: true},
+	{Name: "Ngày Giải phóng miền Nam", Month: 4, Day: 30, Public: true},
+	{Name: "Ngày Quốc tế Lao động", Month: 5, Day: 1, Public: true},
+	{Name: "Lễ Phật Đản", Lunar: true, Month: 4, Day: 15},
+	{Name: "Tết Đoan Ngọ", Lunar: true, Month: 5, Day: 5},
+	{Name: "Lễ Vu Lan", Lunar: true, Month: 7, Day: 15},
+	{Name: "Tết Trung Thu", Lunar: true, Month: 8, Day: 15},
+	{Name: "Quốc khánh", Month: 9, Day: 2, Public: true},
+	{Name: "Ông Công Ông Táo", Lunar: true, Month: 12, Day: 23},
+}
+
+// HolidayDate is a holiday on a date
+type HolidayDate struct {
+	Holiday
+	Date VNDate
+}
+
+// HolidaysIn returns the holidays in the given solar year in date order,
+// lunar holidays may belong to the previous lunar year
+func HolidaysIn(year int) []HolidayDate {
+	return (*Calendar)(nil).HolidaysIn(year)
+}
+
+// HolidaysIn works as the package level HolidaysIn using the calendar settings
+func (c *Calendar) HolidaysIn(year int) []HolidayDate {
+	conv := c.converter()
+	var dates []HolidayDate
+	for _, h := range Holidays {
+		if !h.Lunar {
+			dates = append(dates, HolidayDate{Holiday: h, Date: c.dateOf(year, time.Month(h.Month), h.Day)})
+			continue
+		}
+		for _, lunarYear := range []int{year - 1, year} {
+			d := c.dateOfJDN(conv.lunarJDN(lunarYear, h.Month, h.Day, false))
+			if d.solarTime.Year() == year {
+				dates = append(dates, HolidayDate{Holiday: h, Date: d})
+			}
+		}
+	}
+	sort.SliceStable(dates, func(i, j int) bool {
+		return dates[i].Date.Before(dates[j].Date)
+	})
+	return dates
+}
+
+// Holidays returns the holidays on the date
+func (t VNDate) Holidays() []Holiday {
+	var holidays []Holiday
+	_, month, day := t.solarTime.Date()
+	for _, h := range Holidays {
+		if h.Lunar && !t.lunarDate.Leap && h.Month == t.lunarDate.Month && h.Day == t.lunarDate.Day ||
+			!h.Lunar && h.Month == int(month) && h.Day == day {
+			holidays = append(holidays, h)
+		}
+	}
+	return holidays
+}
+
+// NextLunarDate returns the first date on or after from with the given
+// lunar month and day. Years where the date does not exist, day 30 of
+// a short month or a missing leap month, are skipped. It returns an error
+// if the date does not occur within the supported range
+func NextLunarDate(from VNDate, month, day int, leap bool) (VNDate, error) {
+	if month < 1 || month > 12 {
+		return VNDate{}, ErrInvalidMonth
+	}
+	if day < 1 || day > 30 {
+		return VNDate{}, ErrInvalidDay
+	}
+	c := from.calendar.converter()
+	start := from.JDN()
+	for year := from.lunarDate.Year; year <= MaxSupportedYear; year++ {
+		jdn := c.lunarJDN(year, month, day, leap)
+		if jdn == 0 || jdn < start {
+			continue
+		}
+		if c.lunarDate(jdn) == (LunarDate{Year: year, Month: month, Day: day, Leap: leap}) {
+			return from.calendar.dateOfJDN(jdn), nil
+		}
+	}
+	return VNDate{}, ErrInvalidDate
+}
+
+// dateOf returns the date at midnight of the solar date,
+// safe to call on nil which means default settings
+func (c *Calendar) dateOf(year int, month time.Month, day int) VNDate {
+	loc := VietNamTimeZone
+	if c != nil {
+		loc = c.location
+	}
+	return newVNDate(time.Date(year, month, day, 0, 0, 0, 0, loc), c)
+}
+
+// dateOfJDN returns the date at midnight of the julian day number
+func (c *Calendar) dateOfJDN(jdn int) VNDate {
+	d := ProlepticGregorian.Date(jdn)
+	return c.dateOf(d.Year, time.Month(d.Month), d.Day)
+}
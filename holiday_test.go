@@ -0,0 +1,75 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolidaysIn(t *testing.T) {
+	holidays := HolidaysIn(2025)
+	byName := map[string]HolidayDate{}
+	for i, h := range holidays {
+		byName[h.Name] = h
+		assert.Equal(t, 2025, h.Date.SolarTime().Year())
+		if i > 0 {
+			assert.False(t, h.Date.Before(holidays[i-1].Date))
+		}
+	}
+	assert.Equal(t, "2025-01-29", byName["Tết Nguyên Đán"].Date.SolarTime().Format(time.DateOnly))
+	assert.Equal(t, "2025-04-07", byName["Giỗ Tổ Hùng Vương"].Date.SolarTime().Format(time.DateOnly))
+	assert.Equal(t, "2025-10-06", byName["Tết Trung Thu"].Date.SolarTime().Format(time.DateOnly))
+	assert.Equal(t, "2025-09-02", byName["Quốc khánh"].Date.SolarTime().Format(time.DateOnly))
+	// 23 tháng Chạp of Giáp Thìn, the one of Ất Tỵ is in 2026
+	assert.Equal(t, "2025-01-22", byName["Ông Công Ông Táo"].Date.SolarTime().Format(time.DateOnly))
+	assert.Equal(t, 2024, byName["Ông Công Ông Táo"].Date.Year())
+	assert.Equal(t, "2026-01-01", HolidaysIn(2026)[0].Date.SolarTime().Format(time.DateOnly))
+}
+
+func TestVNDateHolidays(t *testing.T) {
+	d := Date(2025, time.January, 29, 12, 0, 0, 0)
+	holidays := d.Holidays()
+	assert.Len(t, holidays, 1)
+	assert.Equal(t, "Tết Nguyên Đán", holidays[0].Name)
+	assert.True(t, holidays[0].Public)
+	assert.Empty(t, Date(2025, time.January, 30, 12, 0, 0, 0).NextDay().NextDay().Holidays())
+
+	// not in the leap month 6 of Ất Tỵ
+	assert.Empty(t, Date(2025, time.August, 8, 12, 0, 0, 0).Holidays())
+}
+
+func TestNextLunarDate(t *testing.T) {
+	from := Date(2025, time.October, 7, 12, 0, 0, 0)
+	d, err := NextLunarDate(from, 8, 15, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-09-25", d.SolarTime().Format(time.DateOnly))
+	assert.Equal(t, LunarDate{Year: 2026, Month: 8, Day: 15}, d.LunarDate())
+
+	// on the day itself
+	d, err = NextLunarDate(Date(2025, time.October, 6, 12, 0, 0, 0), 8, 15, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025-10-06", d.SolarTime().Format(time.DateOnly))
+
+	// the next leap month 6 after Ất Tỵ
+	d, err = NextLunarDate(from, 6, 1, true)
+	assert.NoError(t, err)
+	assert.True(t, d.LunarDate().Leap)
+	assert.Equal(t, 6, int(d.Month()))
+	assert.Greater(t, d.Year(), 2025)
+
+	// day 30 skips years where the month has 29 days
+	d, err = NextLunarDate(from, 12, 30, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, d.Day())
+
+	c := NewCalendar(WithLocation(time.UTC))
+	d, err = NextLunarDate(c.FromSolarTime(from.SolarTime()), 8, 15, false)
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, d.SolarTime().Location())
+
+	_, err = NextLunarDate(from, 13, 1, false)
+	assert.Error(t, err)
+	_, err = NextLunarDate(from, 1, 31, false)
+	assert.Error(t, err)
+}
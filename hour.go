@@ -0,0 +1,78 @@
+package vncalendar
+
+import "time"
+
+// LunarHour is one of the twelve traditional double hours (canh giờ),
+// giờ Tý lasts from 23:00 of the previous day to 01:00
+type LunarHour struct {
+	CanChi CanChi
+	// Start is inclusive, End is exclusive
+	Start, End time.Time
+	// HoangDao is true for auspicious hours (giờ hoàng đạo) of the day
+	HoangDao bool
+}
+
+func (h LunarHour) Chi() Chi {
+	return h.CanChi.Chi
+}
+
+// Watch returns the night watch (canh) from 1 to 5,
+// canh một starts at giờ Tuất and canh năm ends with giờ Dần.
+// Returns 0 for day time hours
+func (h LunarHour) Watch() int {
+	switch h.Chi() {
+	case Tuat:
+		return 1
+	case Hoi:
+		return 2
+	case Ty:
+		return 3
+	case Suu:
+		return 4
+	case Dan:
+		return 5
+	}
+	return 0
+}
+
+func (h LunarHour) String() string {
+	return "Giờ " + h.CanChi.String()
+}
+
+// newLunarHour returns the hour with branch c of the day starting at midnight dayStart
+func newLunarHour(dayStart time.Time, dayCanChi CanChi, c Chi) LunarHour {
+	start := dayStart.Add(time.Duration(2*int(c)-1) * time.Hour)
+	return LunarHour{
+		CanChi:   CanChi{Can: Can(mod(int(dayCanChi.Can)*2+int(c), 10)), Chi: c},
+		Start:    start,
+		End:      start.Add(2 * time.Hour),
+		HoangDao: isHoangDao(dayCanChi.Chi, c),
+	}
+}
+
+// Hours lists the twelve hours of the date from giờ Tý to giờ Hợi
+// in the time zone of the date
+func (t VNDate) Hours() []LunarHour {
+	dayStart := t.dayStart()
+	dayCanChi := t.DayCanChi()
+	hours := make([]LunarHour, 0, 12)
+	for c := Ty; c <= Hoi; c++ {
+		hours = append(hours, newLunarHour(dayStart, dayCanChi, c))
+	}
+	return hours
+}
+
+// Hour returns the lunar hour of the date's time,
+// from 23:00 it is giờ Tý of the next day
+func (t VNDate) Hour() LunarHour {
+	if t.solarTime.Hour() == 23 && t.dayStart().Before(t.solarTime) {
+		return t.NextDay().Hours()[0]
+	}
+	return t.Hours()[(t.solarTime.Hour()+1)/2%12]
+}
+
+// dayStart returns midnight starting the civil date
+func (t VNDate) dayStart() time.Time {
+	y, m, d := t.civilDate()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.solarTime.Location())
+}
@@ -0,0 +1,51 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHours(t *testing.T) {
+	// Giáp Thìn day
+	d := Date(2024, time.February, 10, 12, 0, 0, 0)
+	hours := d.Hours()
+	assert.Equal(t, 12, len(hours))
+
+	assert.Equal(t, "Giờ Giáp Tý", hours[0].String())
+	assert.Equal(t, time.Date(2024, time.February, 9, 23, 0, 0, 0, VietNamTimeZone), hours[0].Start)
+	assert.Equal(t, time.Date(2024, time.February, 10, 1, 0, 0, 0, VietNamTimeZone), hours[0].End)
+	assert.Equal(t, "Giờ Ất Hợi", hours[11].String())
+	assert.Equal(t, time.Date(2024, time.February, 10, 21, 0, 0, 0, VietNamTimeZone), hours[11].Start)
+
+	var hoangDao []Chi
+	for _, h := range hours {
+		if h.HoangDao {
+			hoangDao = append(hoangDao, h.Chi())
+		}
+	}
+	assert.Equal(t, []Chi{Dan, Thin, Ti, Than, Dau, Hoi}, hoangDao)
+}
+
+func TestHour(t *testing.T) {
+	d := FromSolarTime(time.Date(2024, time.February, 10, 5, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, Mao, d.Hour().Chi())
+
+	d = FromSolarTime(time.Date(2024, time.February, 10, 22, 59, 0, 0, VietNamTimeZone))
+	assert.Equal(t, "Giờ Ất Hợi", d.Hour().String())
+	assert.Equal(t, 2, d.Hour().Watch())
+
+	// 23:30 is giờ Tý of Ất Tỵ day
+	d = d.Add(31 * time.Minute)
+	assert.Equal(t, "Giờ Bính Tý", d.Hour().String())
+	assert.Equal(t, 3, d.Hour().Watch())
+}
+
+func TestWatch(t *testing.T) {
+	hours := Date(2024, time.February, 10, 12, 0, 0, 0).Hours()
+	watches := []int{3, 4, 5, 0, 0, 0, 0, 0, 0, 0, 1, 2}
+	for i, h := range hours {
+		assert.Equal(t, watches[i], h.Watch())
+	}
+}
@@ -0,0 +1,32 @@
+package astro
+
+import "math"
+
+const (
+	// J2000 is the julian day of 2000-01-01 12:00 TT
+	J2000 = 2451545.0
+	// Degree is one degree in radians
+	Degree = math.Pi / 180
+)
+
+// NormalizeDegree returns the angle in [0, 360)
+func NormalizeDegree(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+// Nutation returns nutation in longitude and in obliquity in degrees
+// at the julian ephemeris day, low precision version of Meeus chapter 22
+// accurate to 0.5 and 0.1 arcsecond
+func Nutation(jde float64) (deltaPsi, deltaEpsilon float64) {
+	T := (jde - J2000) / 36525
+	omega := (125.04452 - 1934.136261*T) * Degree
+	l := (280.4665 + 36000.7698*T) * Degree
+	lPr := (218.3165 + 481267.8813*T) * Degree
+	deltaPsi = -17.20*math.Sin(omega) - 1.32*math.Sin(2*l) - 0.23*math.Sin(2*lPr) + 0.21*math.Sin(2*omega)
+	deltaEpsilon = 9.20*math.Cos(omega) + 0.57*math.Cos(2*l) + 0.10*math.Cos(2*lPr) - 0.09*math.Cos(2*omega)
+	return deltaPsi / 3600, deltaEpsilon / 3600
+}
@@ -0,0 +1,35 @@
+package astro
+
+import "math"
+
+// MeanObliquity returns the mean obliquity of the ecliptic in degrees
+// at the julian ephemeris day, Meeus 22.2
+func MeanObliquity(jde float64) float64 {
+	T := (jde - J2000) / 36525
+	return 23.4392911 - 0.0130041667*T - 1.6389e-7*T*T + 5.0361e-7*T*T*T
+}
+
+// Equatorial converts ecliptic longitude and latitude to right ascension
+// and declination, all in degrees, with the obliquity epsilon in degrees
+func Equatorial(lambda, beta, epsilon float64) (ra, dec float64) {
+	l, b, e := lambda*Degree, beta*Degree, epsilon*Degree
+	ra = math.Atan2(math.Sin(l)*math.Cos(e)-math.Tan(b)*math.Sin(e), math.Cos(l))
+	dec = math.Asin(math.Sin(b)*math.Cos(e) + math.Cos(b)*math.Sin(e)*math.Sin(l))
+	return NormalizeDegree(ra / Degree), dec / Degree
+}
+
+// SunEquatorial returns the apparent right ascension and declination
+// of the sun in degrees at the julian ephemeris day
+func SunEquatorial(jde float64) (ra, dec float64) {
+	_, deltaEpsilon := Nutation(jde)
+	return Equatorial(SunApparentLongitude(jde), 0, MeanObliquity(jde)+deltaEpsilon)
+}
+
+// SiderealTime returns the apparent sidereal time at Greenwich in degrees
+// at the julian day in UT, Meeus 12.4 with the equation of the equinoxes
+func SiderealTime(jd float64) float64 {
+	T := (jd - J2000) / 36525
+	mean := 280.46061837 + 360.98564736629*(jd-J2000) + 0.000387933*T*T - T*T*T/38710000
+	deltaPsi, deltaEpsilon := Nutation(jd)
+	return NormalizeDegree(mean + deltaPsi*math.Cos((MeanObliquity(jd)+deltaEpsilon)*Degree))
+}
@@ -0,0 +1,26 @@
+package astro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSiderealTime(t *testing.T) {
+	// Meeus example 12.a, 1987 April 10 0h UT, 13h10m46.1351s apparent
+	assert.InDelta(t, (13+10.0/60+46.1351/3600)*15, SiderealTime(2446895.5), 0.0005)
+}
+
+func TestEquatorial(t *testing.T) {
+	// Meeus example 13.a, Pollux
+	ra, dec := Equatorial(113.215630, 6.684170, 23.4392911)
+	assert.InDelta(t, 116.328942, ra, 0.00001)
+	assert.InDelta(t, 28.026183, dec, 0.00001)
+}
+
+func TestSunEquatorial(t *testing.T) {
+	// Meeus example 25.b, 13h13m30.749s and -7°47'01.74"
+	ra, dec := SunEquatorial(2448908.5)
+	assert.InDelta(t, (13+13.0/60+30.749/3600)*15, ra, 0.0005)
+	assert.InDelta(t, -(7 + 47.0/60 + 1.74/3600), dec, 0.0005)
+}
@@ -0,0 +1,24 @@
+package astro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoonPosition(t *testing.T) {
+	// Meeus example 47.a, 1992 April 12 0h TD
+	lambda, beta, distance := MoonPosition(2448724.5)
+	assert.InDelta(t, 133.162655, lambda, 0.000001)
+	assert.InDelta(t, -3.229126, beta, 0.000001)
+	assert.InDelta(t, 368409.7, distance, 0.1)
+	assert.InDelta(t, 0.991990, MoonParallax(distance), 0.000001)
+}
+
+func TestMoonEquatorial(t *testing.T) {
+	// Meeus example 47.a, apparent 134.688470 and 13.768368
+	// with the full nutation, the low precision one is within an arcsecond
+	ra, dec, _ := MoonEquatorial(2448724.5)
+	assert.InDelta(t, 134.688470, ra, 0.0003)
+	assert.InDelta(t, 13.768368, dec, 0.0003)
+}
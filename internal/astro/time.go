@@ -0,0 +1,19 @@
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// JDFromTime returns the fractional julian day of t
+func JDFromTime(t time.Time) float64 {
+	return (float64(t.Unix())+float64(t.Nanosecond())/1e9)/86400 + 2440587.5
+}
+
+// TimeFromJD returns UTC time of the fractional julian day
+func TimeFromJD(jd float64) time.Time {
+	days := jd - 2440587.5
+	sec := math.Floor(days * 86400)
+	nsec := math.Round((days*86400 - sec) * 1e9)
+	return time.Unix(int64(sec), int64(nsec)).UTC()
+}
@@ -0,0 +1,19 @@
+package astro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJDFromTime(t *testing.T) {
+	assert.Equal(t, J2000, JDFromTime(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)))
+	// Meeus example 7.a, launch of Sputnik 1957 October 4.81
+	assert.InDelta(t, 2436116.31, JDFromTime(time.Date(1957, time.October, 4, 19, 26, 24, 0, time.UTC)), 1e-9)
+
+	// a double holds the julian day to about 40 microseconds
+	at := time.Date(1968, time.January, 29, 23, 59, 59, 500000000, time.FixedZone("+07", 7*3600))
+	assert.WithinDuration(t, at, TimeFromJD(JDFromTime(at)), 100*time.Microsecond)
+	assert.Equal(t, time.UTC, TimeFromJD(J2000).Location())
+}
@@ -0,0 +1,27 @@
+package astro
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEarthHeliocentric(t *testing.T) {
+	// Meeus example 25.b, 1992 October 13.0 TD
+	l, b, r := EarthHeliocentric(2448908.5)
+	assert.InDelta(t, 19.907372, l/Degree, 0.00001)
+	assert.InDelta(t, -0.000179, b/Degree, 0.00001)
+	assert.InDelta(t, 0.99760775, r, 0.0000001)
+}
+
+func TestSunApparentLongitude(t *testing.T) {
+	// Meeus example 25.b
+	assert.InDelta(t, 199.906061, SunApparentLongitude(2448908.5), 0.0002)
+}
+
+func TestNutation(t *testing.T) {
+	// Meeus example 22.a, 1987 April 10.0 TD
+	deltaPsi, deltaEpsilon := Nutation(2446895.5)
+	assert.InDelta(t, -3.788, deltaPsi*3600, 0.5)
+	assert.InDelta(t, 9.443, deltaEpsilon*3600, 0.1)
+}
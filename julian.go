@@ -0,0 +1,75 @@
+package vncalendar
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// JulianDay is a fractional julian day (JD), days since noon UT
+// of 1 January 4713 BC in the Julian calendar.
+// As float64 it resolves times to some microseconds
+type JulianDay float64
+
+// MJDEpoch is the julian day of the modified julian day epoch,
+// midnight UT of 17 November 1858
+const MJDEpoch JulianDay = 2400000.5
+
+// JulianDayOf returns the julian day of the instant t
+func JulianDayOf(t time.Time) JulianDay {
+	return JulianDay(astro.JDFromTime(t))
+}
+
+// FromMJD returns the julian day of a modified julian day
+func FromMJD(mjd float64) JulianDay {
+	return JulianDay(mjd) + MJDEpoch
+}
+
+// Time returns the instant of the julian day in UTC
+func (jd JulianDay) Time() time.Time {
+	return astro.TimeFromJD(float64(jd))
+}
+
+// MJD returns the modified julian day, JD - 2400000.5
+func (jd JulianDay) MJD() float64 {
+	return float64(jd - MJDEpoch)
+}
+
+// JDN returns the julian day number of the UT date, the day
+// that starts at midnight UT on the civil date and noon of the JDN
+func (jd JulianDay) JDN() int {
+	return int(math.Floor(float64(jd) + 0.5))
+}
+
+// JDN returns the julian day number of a solar date with Reform1582.
+// Dates before 15 October 1582 are in the Julian calendar as in
+// the lunar conversion, while time.Time is proleptic Gregorian,
+// so these dates are 10 or more days apart from time.Date of the same numbers
+func JDN(year, month, day int) int {
+	return Reform1582.JDN(year, month, day)
+}
+
+// DateOfJDN returns the solar date of a julian day number with Reform1582,
+// in the Julian calendar before 15 October 1582
+func DateOfJDN(jdn int) SolarDate {
+	return Reform1582.Date(jdn)
+}
+
+// FromJulianDay returns the date at the julian day in Vietnam time zone
+func FromJulianDay(jd JulianDay) VNDate {
+	return FromSolarTime(jd.Time())
+}
+
+// JulianDay returns the julian day of the date's time
+func (t VNDate) JulianDay() JulianDay {
+	return JulianDayOf(t.solarTime)
+}
+
+// JDN returns the julian day number of the solar date the lunar day
+// is derived from, the same day number as used for the day Can-Chi.
+// The date is proleptic Gregorian as time.Time
+func (t VNDate) JDN() int {
+	year, month, day := t.civilDate()
+	return ProlepticGregorian.JDN(year, int(month), day)
+}
@@ -0,0 +1,53 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJulianDay(t *testing.T) {
+	j2000 := JulianDayOf(time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, JulianDay(2451545), j2000)
+	assert.Equal(t, 51544.5, j2000.MJD())
+	assert.Equal(t, j2000, FromMJD(51544.5))
+	assert.Equal(t, 2451545, j2000.JDN())
+	assert.Equal(t, 2451544, (j2000 - 0.6).JDN())
+
+	// Meeus example 7.a, launch of Sputnik 1 on 1957 October 4.81
+	sputnik := JulianDayOf(time.Date(1957, time.October, 4, 19, 26, 24, 0, time.UTC))
+	assert.InDelta(t, 2436116.31, float64(sputnik), 1e-9)
+	assert.WithinDuration(t, time.Date(1957, time.October, 4, 19, 26, 24, 0, time.UTC), sputnik.Time(), time.Millisecond)
+}
+
+func TestJDN(t *testing.T) {
+	assert.Equal(t, 2451545, JDN(2000, 1, 1))
+	// Gregorian switch, 4 October 1582 is followed by 15 October
+	assert.Equal(t, 2299160, JDN(1582, 10, 4))
+	assert.Equal(t, 2299161, JDN(1582, 10, 15))
+	assert.Equal(t, SolarDate{Year: 1582, Month: 10, Day: 4}, DateOfJDN(2299160))
+	assert.Equal(t, SolarDate{Year: 1582, Month: 10, Day: 15}, DateOfJDN(2299161))
+	// Meeus example 7.b, 333 January 27 in the Julian calendar
+	assert.Equal(t, 1842713, JDN(333, 1, 27))
+	// time.Time is proleptic Gregorian before the switch
+	assert.Equal(t, JDN(1582, 10, 4), JulianDayOf(time.Date(1582, time.October, 14, 12, 0, 0, 0, time.UTC)).JDN())
+}
+
+func TestVNDateJulianDay(t *testing.T) {
+	d := FromJulianDay(2460351)
+	assert.Equal(t, time.Date(2024, time.February, 10, 19, 0, 0, 0, VietNamTimeZone), d.SolarTime())
+	assert.Equal(t, LunarDate{Year: 2024, Month: 1, Day: 1}, d.LunarDate())
+	assert.Equal(t, JulianDay(2460351), d.JulianDay())
+	assert.Equal(t, 2460351, d.JDN())
+
+	// at 05:00 in Vietnam the UT date is still the day before
+	d = FromSolarTime(time.Date(2024, time.February, 10, 5, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, 2460351, d.JDN())
+	assert.Equal(t, 2460350, d.JulianDay().JDN())
+
+	// the lunar day starts at 23:00
+	d = NewCalendar(WithDayStartHour(23)).FromSolarTime(time.Date(2024, time.February, 9, 23, 30, 0, 0, VietNamTimeZone))
+	assert.Equal(t, 2460351, d.JDN())
+	assert.Equal(t, DayCanChi(d.JDN()), d.DayCanChi())
+}
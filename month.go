@@ -42,6 +42,40 @@ func GetMonthDates(year int, month time.Month) []VNDate {
 	return dates
 }
 
+// MonthGrid lays out consecutive dates such as GetMonthDates in weeks of
+// seven days starting on weekStart, taken modulo 7. Days before the first
+// and after the last date are nil, the others point into dates
+func MonthGrid(dates []VNDate, weekStart time.Weekday) [][]*VNDate {
+	if len(dates) == 0 {
+		return nil
+	}
+	var weeks [][]*VNDate
+	var week []*VNDate
+	blank := mod(int(dates[0].SolarTime().Weekday())-int(weekStart), 7)
+	for i := -blank; i < len(dates) || len(week) > 0; i++ {
+		if i < 0 || i >= len(dates) {
+			week = append(week, nil)
+		} else {
+			week = append(week, &dates[i])
+		}
+		if len(week) == 7 {
+			weeks = append(weeks, week)
+			week = nil
+		}
+	}
+	return weeks
+}
+
+// WeekDays returns the days of a MonthGrid week starting on weekStart,
+// taken modulo 7
+func WeekDays(weekStart time.Weekday) []time.Weekday {
+	days := make([]time.Weekday, 7)
+	for i := range days {
+		days[i] = time.Weekday(mod(int(weekStart)+i, 7))
+	}
+	return days
+}
+
 func GetYearMonthDates(year int) map[time.Month][]VNDate {
 	months := make(map[time.Month][]VNDate)
 	for _, m := range Months {
@@ -16,6 +16,41 @@ func TestGetMonthDates(t *testing.T) {
 	assert.Equal(t, 29, len(GetMonthDates(2024, time.February)))
 }
 
+func TestMonthGrid(t *testing.T) {
+	dates := GetMonthDates(2025, time.June)
+	// 1 June 2025 is a Sunday
+	weeks := MonthGrid(dates, time.Monday)
+	assert.Len(t, weeks, 6)
+	assert.Nil(t, weeks[0][5])
+	assert.Equal(t, &dates[0], weeks[0][6])
+	assert.Equal(t, 30, weeks[5][0].SolarTime().Day())
+	assert.Nil(t, weeks[5][1])
+	for _, week := range weeks {
+		assert.Len(t, week, 7)
+	}
+
+	weeks = MonthGrid(dates, time.Sunday)
+	assert.Len(t, weeks, 5)
+	assert.Equal(t, 1, weeks[0][0].SolarTime().Day())
+	// modulo 7
+	assert.Equal(t, weeks, MonthGrid(dates, 14))
+	assert.Equal(t, MonthGrid(dates, time.Saturday), MonthGrid(dates, -1))
+	assert.Nil(t, MonthGrid(nil, time.Monday))
+}
+
+func TestWeekDays(t *testing.T) {
+	days := WeekDays(time.Monday)
+	assert.Equal(t, time.Monday, days[0])
+	assert.Equal(t, time.Sunday, days[6])
+	assert.Equal(t, days, WeekDays(8))
+	assert.Equal(t, WeekDays(time.Saturday), WeekDays(-1))
+	// the first day of each week of the grid
+	dates := GetMonthDates(2025, time.June)
+	for _, start := range []time.Weekday{time.Sunday, time.Wednesday, -5} {
+		assert.Equal(t, WeekDays(start)[0], MonthGrid(dates, start)[1][0].SolarTime().Weekday())
+	}
+}
+
 func TestGetYearMonthDates(t *testing.T) {
 	assert.Equal(t, 12, len(GetYearMonthDates(2016)))
 }
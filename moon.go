@@ -0,0 +1,18 @@
+package vncalendar
+
+import (
+	"github.com/vanng822/vncalendar/moon"
+	"github.com/vanng822/vncalendar/place"
+)
+
+// MoonPhase returns the moon phase, age and illumination at the date's time
+// with the ΔT model of the calendar, phase times are in the date's time zone
+func (t VNDate) MoonPhase() moon.Info {
+	return moon.At(t.solarTime, t.DeltaT())
+}
+
+// MoonRiseSet returns moonrise, transit and moonset on the date's solar day
+// at the place with the ΔT model of the calendar, in the date's time zone
+func (t VNDate) MoonRiseSet(p place.Place) moon.RiseSet {
+	return moon.RiseSetOn(t.solarTime, p, t.DeltaT())
+}
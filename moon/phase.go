@@ -0,0 +1,261 @@
+// Package moon computes moon phases based on
+// Jean Meeus, Astronomical Algorithms, 2nd edition
+package moon
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// Phase is one of the four principal moon phases
+type Phase int
+
+const (
+	NewMoon Phase = iota
+	FirstQuarter
+	FullMoon
+	LastQuarter
+)
+
+var phaseNames = []string{"Trăng non", "Thượng huyền", "Trăng tròn", "Hạ huyền"}
+
+func (p Phase) String() string {
+	// phases repeat every lunation
+	return phaseNames[(int(p)%4+4)%4]
+}
+
+// SynodicMonth is the mean length of a lunation in days
+const SynodicMonth = 29.530588861
+
+const dr = math.Pi / 180
+
+// Event is the exact time a phase occurs
+type Event struct {
+	Phase Phase
+	Time  time.Time
+}
+
+// Lunation returns the lunation number k of the new moon at or before t,
+// k is 0 for the new moon of 2000-01-06
+func Lunation(t time.Time, model deltat.Model) int {
+	k := int(math.Floor((astro.JDFromTime(t) - 2451550.09766) / SynodicMonth))
+	for !PhaseTime(k+1, NewMoon, model).After(t) {
+		k++
+	}
+	for PhaseTime(k, NewMoon, model).After(t) {
+		k--
+	}
+	return k
+}
+
+// PhaseTime returns the time in UTC when the phase occurs in lunation k,
+// the model converts terrestrial time to universal time,
+// e.g. deltat.EspenakMeeus or VNDate.DeltaT of the calendar
+func PhaseTime(k int, p Phase, model deltat.Model) time.Time {
+	jde := PhaseJDE(k, p)
+	return astro.TimeFromJD(jde - deltat.Days(model, jde))
+}
+
+// PhaseJDE returns the julian ephemeris day, in terrestrial time,
+// when the phase occurs in lunation k
+func PhaseJDE(k int, p Phase) float64 {
+	return phaseJDE(float64(k) + float64(p)/4)
+}
+
+// Events returns all principal phases occurring in [from, to) in time order
+func Events(from, to time.Time, model deltat.Model) []Event {
+	var events []Event
+	for k := Lunation(from, model); ; k++ {
+		for p := NewMoon; p <= LastQuarter; p++ {
+			t := PhaseTime(k, p, model)
+			if !t.Before(to) {
+				sort.Slice(events, func(i, j int) bool {
+					return events[i].Time.Before(events[j].Time)
+				})
+				return events
+			}
+			if !t.Before(from) {
+				events = append(events, Event{Phase: p, Time: t})
+			}
+		}
+	}
+}
+
+// Info describes the moon at a given time
+type Info struct {
+	Time time.Time
+	// Age is days since the new moon
+	Age float64
+	// Illumination is the illuminated fraction of the disk from 0 to 1
+	Illumination float64
+	// Phase is the latest principal phase at Time
+	Phase Phase
+	// Principal phases of the lunation containing Time
+	NewMoon, FirstQuarter, FullMoon, LastQuarter time.Time
+}
+
+// At returns moon information at t, times are in the location of t
+func At(t time.Time, model deltat.Model) Info {
+	k := Lunation(t, model)
+	info := Info{
+		Time:         t,
+		NewMoon:      PhaseTime(k, NewMoon, model).In(t.Location()),
+		FirstQuarter: PhaseTime(k, FirstQuarter, model).In(t.Location()),
+		FullMoon:     PhaseTime(k, FullMoon, model).In(t.Location()),
+		LastQuarter:  PhaseTime(k, LastQuarter, model).In(t.Location()),
+	}
+	info.Age = t.Sub(info.NewMoon).Hours() / 24
+	info.Illumination = Illumination(t, model)
+	for i, pt := range []time.Time{info.NewMoon, info.FirstQuarter, info.FullMoon, info.LastQuarter} {
+		if !pt.After(t) {
+			info.Phase = Phase(i)
+		}
+	}
+	return info
+}
+
+// Illumination returns the illuminated fraction of the moon's disk at t,
+// using the phase angle approximation of Meeus (48.4)
+func Illumination(t time.Time, model deltat.Model) float64 {
+	T := (jdeFromJD(astro.JDFromTime(t), model) - 2451545) / 36525
+	D := 297.8501921 + 445267.1114034*T - 0.0018819*T*T + T*T*T/545868 - T*T*T*T/113065000
+	M := 357.5291092 + 35999.0502909*T - 0.0001536*T*T + T*T*T/24490000
+	mPr := 134.9633964 + 477198.8675055*T + 0.0087414*T*T + T*T*T/69699 - T*T*T*T/14712000
+	i := 180 - D - 6.289*math.Sin(mPr*dr) + 2.100*math.Sin(M*dr) - 1.274*math.Sin((2*D-mPr)*dr) -
+		0.658*math.Sin(2*D*dr) - 0.214*math.Sin(2*mPr*dr) - 0.110*math.Sin(D*dr)
+	return (1 + math.Cos(i*dr)) / 2
+}
+
+// phaseJDE returns the julian ephemeris day of the phase (Meeus chapter 49),
+// k is integer for new moons and has fraction .25, .5 and .75 for other phases
+func phaseJDE(k float64) float64 {
+	T := k / 1236.85
+	T2 := T * T
+	T3 := T2 * T
+	T4 := T3 * T
+	jde := 2451550.09766 + SynodicMonth*k + 0.00015437*T2 - 0.000000150*T3 + 0.00000000073*T4
+	E := 1 - 0.002516*T - 0.0000074*T2
+	M := (2.5534 + 29.10535670*k - 0.0000014*T2 - 0.00000011*T3) * dr
+	mPr := (201.5643 + 385.81693528*k + 0.0107582*T2 + 0.00001238*T3 - 0.000000058*T4) * dr
+	F := (160.7108 + 390.67050284*k - 0.0016118*T2 - 0.00000227*T3 + 0.000000011*T4) * dr
+	omega := (124.7746 - 1.56375588*k + 0.0020672*T2 + 0.00000215*T3) * dr
+
+	var c float64
+	switch frac := k - math.Floor(k); {
+	case frac < 0.1:
+		c = -0.40720*math.Sin(mPr) +
+			0.17241*E*math.Sin(M) +
+			0.01608*math.Sin(2*mPr) +
+			0.01039*math.Sin(2*F) +
+			0.00739*E*math.Sin(mPr-M) -
+			0.00514*E*math.Sin(mPr+M) +
+			0.00208*E*E*math.Sin(2*M) -
+			0.00111*math.Sin(mPr-2*F) -
+			0.00057*math.Sin(mPr+2*F) +
+			0.00056*E*math.Sin(2*mPr+M) -
+			0.00042*math.Sin(3*mPr) +
+			0.00042*E*math.Sin(M+2*F) +
+			0.00038*E*math.Sin(M-2*F) -
+			0.00024*E*math.Sin(2*mPr-M) -
+			0.00017*math.Sin(omega) -
+			0.00007*math.Sin(mPr+2*M) +
+			0.00004*math.Sin(2*mPr-2*F) +
+			0.00004*math.Sin(3*M) +
+			0.00003*math.Sin(mPr+M-2*F) +
+			0.00003*math.Sin(2*mPr+2*F) -
+			0.00003*math.Sin(mPr+M+2*F) +
+			0.00003*math.Sin(mPr-M+2*F) -
+			0.00002*math.Sin(mPr-M-2*F) -
+			0.00002*math.Sin(3*mPr+M) +
+			0.00002*math.Sin(4*mPr)
+	case frac > 0.4 && frac < 0.6:
+		c = -0.40614*math.Sin(mPr) +
+			0.17302*E*math.Sin(M) +
+			0.01614*math.Sin(2*mPr) +
+			0.01043*math.Sin(2*F) +
+			0.00734*E*math.Sin(mPr-M) -
+			0.00515*E*math.Sin(mPr+M) +
+			0.00209*E*E*math.Sin(2*M) -
+			0.00111*math.Sin(mPr-2*F) -
+			0.00057*math.Sin(mPr+2*F) +
+			0.00056*E*math.Sin(2*mPr+M) -
+			0.00042*math.Sin(3*mPr) +
+			0.00042*E*math.Sin(M+2*F) +
+			0.00038*E*math.Sin(M-2*F) -
+			0.00024*E*math.Sin(2*mPr-M) -
+			0.00017*math.Sin(omega) -
+			0.00007*math.Sin(mPr+2*M) +
+			0.00004*math.Sin(2*mPr-2*F) +
+			0.00004*math.Sin(3*M) +
+			0.00003*math.Sin(mPr+M-2*F) +
+			0.00003*math.Sin(2*mPr+2*F) -
+			0.00003*math.Sin(mPr+M+2*F) +
+			0.00003*math.Sin(mPr-M+2*F) -
+			0.00002*math.Sin(mPr-M-2*F) -
+			0.00002*math.Sin(3*mPr+M) +
+			0.00002*math.Sin(4*mPr)
+	default:
+		c = -0.62801*math.Sin(mPr) +
+			0.17172*E*math.Sin(M) -
+			0.01183*E*math.Sin(mPr+M) +
+			0.00862*math.Sin(2*mPr) +
+			0.00804*math.Sin(2*F) +
+			0.00454*E*math.Sin(mPr-M) +
+			0.00204*E*E*math.Sin(2*M) -
+			0.00180*math.Sin(mPr-2*F) -
+			0.00070*math.Sin(mPr+2*F) -
+			0.00040*math.Sin(3*mPr) -
+			0.00034*E*math.Sin(2*mPr-M) +
+			0.00032*E*math.Sin(M+2*F) +
+			0.00032*E*math.Sin(M-2*F) -
+			0.00028*E*E*math.Sin(mPr+2*M) +
+			0.00027*E*math.Sin(2*mPr+M) -
+			0.00017*math.Sin(omega) -
+			0.00005*math.Sin(mPr-M-2*F) +
+			0.00004*math.Sin(2*mPr+2*F) -
+			0.00004*math.Sin(mPr+M+2*F) +
+			0.00004*math.Sin(mPr-2*M) +
+			0.00003*math.Sin(mPr+M-2*F) +
+			0.00003*math.Sin(3*M) +
+			0.00002*math.Sin(2*mPr-2*F) +
+			0.00002*math.Sin(mPr-M+2*F) -
+			0.00002*math.Sin(3*mPr+M)
+		W := 0.00306 - 0.00038*E*math.Cos(M) + 0.00026*math.Cos(mPr) -
+			0.00002*math.Cos(mPr-M) + 0.00002*math.Cos(mPr+M) + 0.00002*math.Cos(2*F)
+		if frac < 0.5 {
+			c += W
+		} else {
+			c -= W
+		}
+	}
+
+	// planetary arguments
+	a := []float64{
+		299.77 + 0.107408*k - 0.009173*T2,
+		251.88 + 0.016321*k,
+		251.83 + 26.651886*k,
+		349.42 + 36.412478*k,
+		84.66 + 18.206239*k,
+		141.74 + 53.303771*k,
+		207.14 + 2.453732*k,
+		154.84 + 7.306860*k,
+		34.52 + 27.261239*k,
+		207.19 + 0.121824*k,
+		291.34 + 1.844379*k,
+		161.72 + 24.198154*k,
+		239.56 + 25.513099*k,
+		331.55 + 3.592518*k,
+	}
+	coefficients := []float64{
+		0.000325, 0.000165, 0.000164, 0.000126, 0.000110, 0.000062, 0.000060,
+		0.000056, 0.000047, 0.000042, 0.000040, 0.000037, 0.000035, 0.000023,
+	}
+	for i, coefficient := range coefficients {
+		c += coefficient * math.Sin(a[i]*dr)
+	}
+	return jde + c
+}
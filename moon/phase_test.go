@@ -0,0 +1,75 @@
+package moon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+)
+
+func TestPhaseString(t *testing.T) {
+	assert.Equal(t, "Trăng tròn", FullMoon.String())
+	assert.Equal(t, "Trăng non", Phase(4).String())
+	assert.Equal(t, "Hạ huyền", Phase(-1).String())
+}
+
+func TestPhaseJDE(t *testing.T) {
+	// Meeus example 49.a, new moon of 1977 February
+	assert.InDelta(t, 2443192.65118, phaseJDE(-283), 0.00001)
+	// Meeus example 49.b, first last quarter of 2044
+	assert.InDelta(t, 2467636.49186, phaseJDE(544.75), 0.00001)
+}
+
+func TestPhaseTime(t *testing.T) {
+	assert.WithinDuration(t, time.Date(2024, time.February, 9, 22, 59, 0, 0, time.UTC), PhaseTime(298, NewMoon, deltat.EspenakMeeus), 2*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.February, 16, 15, 1, 0, 0, time.UTC), PhaseTime(298, FirstQuarter, deltat.EspenakMeeus), 2*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.February, 24, 12, 30, 0, 0, time.UTC), PhaseTime(298, FullMoon, deltat.EspenakMeeus), 2*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.March, 3, 15, 23, 0, 0, time.UTC), PhaseTime(298, LastQuarter, deltat.EspenakMeeus), 2*time.Minute)
+}
+
+func TestLunation(t *testing.T) {
+	nm := PhaseTime(298, NewMoon, deltat.EspenakMeeus)
+	assert.Equal(t, 298, Lunation(nm, deltat.EspenakMeeus))
+	assert.Equal(t, 297, Lunation(nm.Add(-time.Second), deltat.EspenakMeeus))
+	assert.Equal(t, 298, Lunation(nm.Add(20*24*time.Hour), deltat.EspenakMeeus))
+	assert.Equal(t, 0, Lunation(time.Date(2000, time.January, 7, 0, 0, 0, 0, time.UTC), deltat.EspenakMeeus))
+}
+
+func TestEvents(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	events := Events(from, to, deltat.EspenakMeeus)
+	assert.Equal(t, 50, len(events))
+	assert.Equal(t, LastQuarter, events[0].Phase)
+	for i, e := range events {
+		assert.False(t, e.Time.Before(from))
+		assert.True(t, e.Time.Before(to))
+		if i > 0 {
+			assert.Equal(t, (events[i-1].Phase+1)%4, e.Phase)
+			assert.True(t, events[i-1].Time.Before(e.Time))
+		}
+	}
+}
+
+func TestIllumination(t *testing.T) {
+	// Meeus example 48.a, 1992 April 12 0h TD
+	at := time.Date(1992, time.April, 11, 23, 59, 0, 0, time.UTC)
+	assert.InDelta(t, 0.6786, Illumination(at, deltat.EspenakMeeus), 0.002)
+
+	assert.InDelta(t, 0, Illumination(PhaseTime(298, NewMoon, deltat.EspenakMeeus), deltat.EspenakMeeus), 0.001)
+	assert.InDelta(t, 1, Illumination(PhaseTime(298, FullMoon, deltat.EspenakMeeus), deltat.EspenakMeeus), 0.001)
+	assert.InDelta(t, 0.5, Illumination(PhaseTime(298, FirstQuarter, deltat.EspenakMeeus), deltat.EspenakMeeus), 0.01)
+}
+
+func TestAt(t *testing.T) {
+	loc := time.FixedZone("ICT", 7*60*60)
+	at := time.Date(2024, time.February, 20, 12, 0, 0, 0, loc)
+	info := At(at, deltat.EspenakMeeus)
+	assert.Equal(t, FirstQuarter, info.Phase)
+	assert.Equal(t, loc, info.NewMoon.Location())
+	assert.WithinDuration(t, PhaseTime(298, NewMoon, deltat.EspenakMeeus), info.NewMoon, 0)
+	assert.WithinDuration(t, PhaseTime(298, FullMoon, deltat.EspenakMeeus), info.FullMoon, 0)
+	assert.InDelta(t, at.Sub(info.NewMoon).Hours()/24, info.Age, 1e-9)
+	assert.True(t, info.Illumination > 0.5 && info.Illumination < 1)
+}
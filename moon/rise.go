@@ -0,0 +1,117 @@
+package moon
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/internal/astro"
+	"github.com/vanng822/vncalendar/place"
+)
+
+// RiseSet holds moonrise, transit and moonset on a day. The moon rises
+// about 50 minutes later each day so once a month a day has no moonrise
+// and another has no moonset, an event that does not happen is zero time
+type RiseSet struct {
+	Rise time.Time
+	// Transit is when the moon crosses the meridian, highest in the sky
+	Transit time.Time
+	Set     time.Time
+	// TransitAltitude is the altitude in degrees at the transit
+	TransitAltitude float64
+}
+
+// RiseSetOn returns the moon events on the calendar day of t
+// at the place, in the location of t, the model converts universal time
+// to the terrestrial time of the moon's position
+func RiseSetOn(t time.Time, p place.Place, model deltat.Model) RiseSet {
+	loc := t.Location()
+	from := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+	start, end := astro.JDFromTime(from), astro.JDFromTime(to)
+
+	var events RiseSet
+	toTime := func(jd float64) time.Time {
+		return astro.TimeFromJD(jd).In(loc).Round(time.Second)
+	}
+	// the moon moves about 15 degrees per hour in hour angle,
+	// hourly steps cannot miss a crossing
+	const step = 1.0 / 24
+	prevJD := start
+	prevAlt, prevHourAngle := horizon(prevJD, p, model)
+	for prevJD < end {
+		jd := math.Min(prevJD+step, end)
+		alt, hourAngle := horizon(jd, p, model)
+		if prevAlt < 0 && alt >= 0 && events.Rise.IsZero() {
+			events.Rise = toTime(bisect(prevJD, jd, func(jd float64) bool {
+				alt, _ := horizon(jd, p, model)
+				return alt >= 0
+			}))
+		}
+		if prevAlt >= 0 && alt < 0 && events.Set.IsZero() {
+			events.Set = toTime(bisect(prevJD, jd, func(jd float64) bool {
+				alt, _ := horizon(jd, p, model)
+				return alt < 0
+			}))
+		}
+		// upper transit, the hour angle jumps from 180 to -180 at the lower one
+		if prevHourAngle < 0 && hourAngle >= 0 && hourAngle-prevHourAngle < 180 && events.Transit.IsZero() {
+			transit := bisect(prevJD, jd, func(jd float64) bool {
+				_, hourAngle := horizon(jd, p, model)
+				return hourAngle >= 0
+			})
+			events.Transit = toTime(transit)
+			events.TransitAltitude = altitude(transit, p, model)
+		}
+		prevJD, prevAlt, prevHourAngle = jd, alt, hourAngle
+	}
+	return events
+}
+
+// Altitude returns the altitude in degrees of the moon's center
+// above the horizon of the place at t, corrected for parallax
+// but not for refraction
+func Altitude(t time.Time, p place.Place, model deltat.Model) float64 {
+	return altitude(astro.JDFromTime(t), p, model)
+}
+
+// altitude returns the topocentric altitude of the moon in degrees
+// at the julian day in UT
+func altitude(jd float64, p place.Place, model deltat.Model) float64 {
+	ra, dec, distance := astro.MoonEquatorial(jdeFromJD(jd, model))
+	h := geocentricAltitude(jd, p, ra, dec)
+	return h - astro.MoonParallax(distance)*math.Cos(h*dr)
+}
+
+// horizon returns the geocentric altitude of the moon relative to
+// the standard altitude of moonrise, positive when the moon's upper limb
+// is above the horizon, and the local hour angle in (-180, 180],
+// both in degrees at the julian day in UT
+func horizon(jd float64, p place.Place, model deltat.Model) (alt, hourAngle float64) {
+	ra, dec, distance := astro.MoonEquatorial(jdeFromJD(jd, model))
+	// Meeus chapter 15, parallax minus refraction and semi-diameter
+	standard := 0.7275*astro.MoonParallax(distance) - 0.5667
+	hourAngle = astro.NormalizeDegree(astro.SiderealTime(jd)+p.Longitude-ra+180) - 180
+	return geocentricAltitude(jd, p, ra, dec) - standard, hourAngle
+}
+
+func geocentricAltitude(jd float64, p place.Place, ra, dec float64) float64 {
+	hourAngle := astro.SiderealTime(jd) + p.Longitude - ra
+	sinLat, cosLat := math.Sincos(p.Latitude * dr)
+	sinDec, cosDec := math.Sincos(dec * dr)
+	return math.Asin(sinLat*sinDec+cosLat*cosDec*math.Cos(hourAngle*dr)) / dr
+}
+
+// bisect returns the julian day in [from, to] where ok turns true,
+// to about a tenth of a second
+func bisect(from, to float64, ok func(jd float64) bool) float64 {
+	for to-from > 1e-6 {
+		mid := (from + to) / 2
+		if ok(mid) {
+			to = mid
+		} else {
+			from = mid
+		}
+	}
+	return to
+}
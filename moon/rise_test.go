@@ -0,0 +1,66 @@
+package moon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/place"
+)
+
+var ict = time.FixedZone("ICT", 7*3600)
+
+func TestRiseSetOn(t *testing.T) {
+	// Tết Trung Thu 2024, the full moon rises around sunset
+	e := RiseSetOn(time.Date(2024, time.September, 17, 20, 0, 0, 0, ict), place.HaNoi, deltat.EspenakMeeus)
+	assert.WithinDuration(t, time.Date(2024, time.September, 17, 17, 31, 0, 0, ict), e.Rise, 2*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.September, 17, 23, 31, 0, 0, ict), e.Transit, 2*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.September, 17, 4, 33, 0, 0, ict), e.Set, 2*time.Minute)
+	assert.Equal(t, ict, e.Rise.Location())
+
+	// upper limb on the horizon with refraction
+	assert.InDelta(t, -0.84, Altitude(e.Rise, place.HaNoi, deltat.EspenakMeeus), 0.05)
+	assert.InDelta(t, -0.84, Altitude(e.Set, place.HaNoi, deltat.EspenakMeeus), 0.05)
+	assert.InDelta(t, e.TransitAltitude, Altitude(e.Transit, place.HaNoi, deltat.EspenakMeeus), 1e-3)
+	assert.Less(t, Altitude(e.Transit.Add(-20*time.Minute), place.HaNoi, deltat.EspenakMeeus), e.TransitAltitude)
+	assert.Less(t, Altitude(e.Transit.Add(20*time.Minute), place.HaNoi, deltat.EspenakMeeus), e.TransitAltitude)
+}
+
+func TestRiseSetMissing(t *testing.T) {
+	// every event is missing on about one day a lunation
+	var noRise, noTransit, noSet int
+	from := time.Date(2024, time.September, 1, 0, 0, 0, 0, ict)
+	for i := range 30 {
+		e := RiseSetOn(from.AddDate(0, 0, i), place.HoChiMinhCity, deltat.EspenakMeeus)
+		if e.Rise.IsZero() {
+			noRise++
+		}
+		if e.Transit.IsZero() {
+			noTransit++
+			assert.Zero(t, e.TransitAltitude)
+		}
+		if e.Set.IsZero() {
+			noSet++
+		}
+	}
+	assert.Equal(t, 1, noRise)
+	assert.Equal(t, 1, noTransit)
+	assert.Equal(t, 1, noSet)
+
+	// no transit on the day after Trung Thu in Hà Nội, it is just before midnight
+	e := RiseSetOn(time.Date(2024, time.September, 18, 0, 0, 0, 0, ict), place.HaNoi, deltat.EspenakMeeus)
+	assert.True(t, e.Transit.IsZero())
+	assert.False(t, e.Rise.IsZero())
+	assert.False(t, e.Set.IsZero())
+}
+
+func TestRiseSetPolar(t *testing.T) {
+	// the moon does not rise at the north pole around the southern
+	// extreme of its declination
+	pole := place.Place{Name: "North pole", Latitude: 90}
+	e := RiseSetOn(time.Date(2024, time.September, 12, 0, 0, 0, 0, time.UTC), pole, deltat.EspenakMeeus)
+	assert.True(t, e.Rise.IsZero())
+	assert.True(t, e.Set.IsZero())
+	assert.Less(t, Altitude(time.Date(2024, time.September, 12, 12, 0, 0, 0, time.UTC), pole, deltat.EspenakMeeus), 0.0)
+}
@@ -0,0 +1,8 @@
+package moon
+
+import "github.com/vanng822/vncalendar/deltat"
+
+// jdeFromJD returns julian ephemeris day of julian day in UT
+func jdeFromJD(jd float64, model deltat.Model) float64 {
+	return jd + deltat.Days(model, jd)
+}
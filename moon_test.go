@@ -0,0 +1,57 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/moon"
+	"github.com/vanng822/vncalendar/place"
+)
+
+func TestMoonPhase(t *testing.T) {
+	// rằm tháng Giêng Giáp Thìn
+	d := FromSolarTime(time.Date(2024, time.February, 24, 20, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, 15, d.Day())
+	info := d.MoonPhase()
+	assert.Equal(t, moon.FullMoon, info.Phase)
+	assert.Equal(t, VietNamTimeZone, info.FullMoon.Location())
+	// new moon at 05:59 on mùng 1
+	assert.Equal(t, 10, info.NewMoon.Day())
+	assert.InDelta(t, 14.6, info.Age, 0.1)
+	assert.True(t, info.Illumination > 0.99)
+}
+
+func TestMoonRiseSet(t *testing.T) {
+	// rằm tháng Tám Giáp Thìn, Tết Trung Thu
+	d := FromSolarTime(time.Date(2024, time.September, 17, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, 15, d.Day())
+	assert.Equal(t, 8, int(d.Month()))
+	e := d.MoonRiseSet(place.HaNoi)
+	assert.Equal(t, VietNamTimeZone, e.Rise.Location())
+	assert.Equal(t, 17, e.Rise.Hour())
+	assert.Equal(t, 23, e.Transit.Hour())
+	assert.True(t, e.Rise.Before(e.Transit))
+}
+
+func TestMoonPhaseDeltaT(t *testing.T) {
+	// an hour more ΔT, the phases happen an hour earlier in universal time
+	table := deltat.NewTable([]deltat.Entry{{Year: 2024, Seconds: deltat.EspenakMeeus.Seconds(2024) + 3600}})
+	solarTime := time.Date(2024, time.February, 24, 20, 0, 0, 0, VietNamTimeZone)
+	expected := FromSolarTime(solarTime).MoonPhase()
+	d := NewCalendar(WithDeltaT(table)).FromSolarTime(solarTime)
+	assert.Equal(t, table, d.DeltaT())
+	assert.InDelta(t, -time.Hour, d.MoonPhase().FullMoon.Sub(expected.FullMoon), float64(time.Minute))
+	assert.Equal(t, deltat.EspenakMeeus, FromSolarTime(solarTime).DeltaT())
+}
+
+func TestMoonRiseSetDeltaT(t *testing.T) {
+	// an hour more ΔT, the moon is half a degree further east
+	// and rises about two minutes later
+	table := deltat.NewTable([]deltat.Entry{{Year: 2024, Seconds: deltat.EspenakMeeus.Seconds(2024) + 3600}})
+	solarTime := time.Date(2024, time.September, 17, 12, 0, 0, 0, VietNamTimeZone)
+	e := FromSolarTime(solarTime).MoonRiseSet(place.HaNoi)
+	shifted := NewCalendar(WithDeltaT(table)).FromSolarTime(solarTime).MoonRiseSet(place.HaNoi)
+	assert.InDelta(t, 2, shifted.Rise.Sub(e.Rise).Minutes(), 0.5)
+}
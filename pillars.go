@@ -0,0 +1,162 @@
+package vncalendar
+
+import (
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// Element is one of the five elements (ngũ hành),
+// each element generates the next one and controls the one after
+type Element int
+
+const (
+	Moc  Element = iota // Mộc, wood
+	Hoa                 // Hỏa, fire
+	Tho                 // Thổ, earth
+	Kim                 // Kim, metal
+	Thuy                // Thủy, water
+)
+
+var elementNames = []string{"Mộc", "Hỏa", "Thổ", "Kim", "Thủy"}
+
+func (e Element) String() string {
+	return elementNames[mod(int(e), 5)]
+}
+
+func (c Can) Element() Element {
+	return Element(mod(int(c), 10) / 2)
+}
+
+// IsYang reports whether the stem is dương, otherwise it is âm
+func (c Can) IsYang() bool {
+	return mod(int(c), 2) == 0
+}
+
+var chiElements = []Element{Thuy, Tho, Moc, Moc, Tho, Hoa, Hoa, Tho, Kim, Kim, Tho, Thuy}
+
+func (c Chi) Element() Element {
+	return chiElements[mod(int(c), 12)]
+}
+
+// IsYang reports whether the branch is dương, otherwise it is âm
+func (c Chi) IsYang() bool {
+	return mod(int(c), 2) == 0
+}
+
+// Main hidden stem (bản khí) of each branch
+var chiMainStems = []Can{Quy, Ky, Giap, At, Mau, Binh, Dinh, Ky, Canh, Tan, Mau, Nham}
+
+// MainStem returns the main hidden stem of the branch
+func (c Chi) MainStem() Can {
+	return chiMainStems[mod(int(c), 12)]
+}
+
+// TenGod is the relationship (thập thần) of a stem to the day master
+type TenGod int
+
+const (
+	TyKien     TenGod = iota // Tỷ Kiên, same element and polarity
+	KiepTai                  // Kiếp Tài, same element
+	ThucThan                 // Thực Thần, generated by day master with same polarity
+	ThuongQuan               // Thương Quan, generated by day master
+	ThienTai                 // Thiên Tài, controlled by day master with same polarity
+	ChinhTai                 // Chính Tài, controlled by day master
+	ThatSat                  // Thất Sát, controls day master with same polarity
+	ChinhQuan                // Chính Quan, controls day master
+	ThienAn                  // Thiên Ấn, generates day master with same polarity
+	ChinhAn                  // Chính Ấn, generates day master
+)
+
+var tenGodNames = []string{
+	"Tỷ Kiên", "Kiếp Tài", "Thực Thần", "Thương Quan", "Thiên Tài",
+	"Chính Tài", "Thất Sát", "Chính Quan", "Thiên Ấn", "Chính Ấn",
+}
+
+func (g TenGod) String() string {
+	return tenGodNames[mod(int(g), 10)]
+}
+
+// TenGodOf returns the relationship of stem c to the day master
+func TenGodOf(dayMaster, c Can) TenGod {
+	god := mod(int(c.Element())-int(dayMaster.Element()), 5) * 2
+	if c.IsYang() != dayMaster.IsYang() {
+		god++
+	}
+	return TenGod(god)
+}
+
+// Pillar is one of the four pillars with its relationships to the day master
+type Pillar struct {
+	CanChi
+	// TenGod is relationship of the stem
+	TenGod TenGod
+	// BranchTenGod is relationship of the main hidden stem of the branch
+	BranchTenGod TenGod
+}
+
+// FourPillars (tứ trụ, bát tự) of a birth time
+type FourPillars struct {
+	Year, Month, Day, Hour Pillar
+}
+
+// DayMaster returns the stem of the day pillar (nhật chủ)
+func (p FourPillars) DayMaster() Can {
+	return p.Day.Can
+}
+
+// Pillars returns year, month, day and hour pillars in that order
+func (p FourPillars) Pillars() []Pillar {
+	return []Pillar{p.Year, p.Month, p.Day, p.Hour}
+}
+
+// ElementCounts counts elements of the eight characters,
+// four stems and four branches
+func (p FourPillars) ElementCounts() map[Element]int {
+	counts := map[Element]int{Moc: 0, Hoa: 0, Tho: 0, Kim: 0, Thuy: 0}
+	for _, pillar := range p.Pillars() {
+		counts[pillar.Can.Element()]++
+		counts[pillar.Chi.Element()]++
+	}
+	return counts
+}
+
+// FourPillars returns the four pillars of the date's time.
+// Year changes at Lập xuân and months change at the solar terms
+// starting each month (tiết), not at lunar new year or lunar months.
+// Day and hour pillars respect the calendar's day start hour
+func (t VNDate) FourPillars() FourPillars {
+	a := t.astronomy()
+	jd := astro.JDFromTime(t.solarTime)
+	year := t.solarTime.Year()
+	if jd < solarTermJD(a, year, LapXuan.Longitude()) {
+		year--
+	}
+	// month 0 is Dần starting at Lập xuân
+	month := int(a.apparentSunLongitude(jd)-LapXuan.Longitude()+360) % 360 / 30
+	yearCanChi := YearCanChi(year)
+	monthCanChi := CanChi{
+		Can: Can(mod(int(yearCanChi.Can)*2+2+month, 10)),
+		Chi: Chi(mod(int(Dan)+month, 12)),
+	}
+	dayCanChi := t.DayCanChi()
+
+	newPillar := func(c CanChi) Pillar {
+		return Pillar{
+			CanChi:       c,
+			TenGod:       TenGodOf(dayCanChi.Can, c.Can),
+			BranchTenGod: TenGodOf(dayCanChi.Can, c.Chi.MainStem()),
+		}
+	}
+	return FourPillars{
+		Year:  newPillar(yearCanChi),
+		Month: newPillar(monthCanChi),
+		Day:   newPillar(dayCanChi),
+		Hour:  newPillar(t.Hour().CanChi),
+	}
+}
+
+// FourPillarsOf returns the four pillars of a birth time in Vietnam time zone
+func FourPillarsOf(birth time.Time) FourPillars {
+	return FromSolarTime(birth).FourPillars()
+}
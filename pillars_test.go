@@ -0,0 +1,78 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFourPillarsAtLapXuan(t *testing.T) {
+	// Lập xuân 2024 is at 15:27 in Vietnam
+	p := FourPillarsOf(time.Date(2024, time.February, 4, 15, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, "Quý Mão", p.Year.String())
+	assert.Equal(t, "Ất Sửu", p.Month.String())
+	assert.Equal(t, "Mậu Tuất", p.Day.String())
+	assert.Equal(t, "Canh Thân", p.Hour.String())
+
+	p = FourPillarsOf(time.Date(2024, time.February, 4, 16, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, "Giáp Thìn", p.Year.String())
+	assert.Equal(t, "Bính Dần", p.Month.String())
+	assert.Equal(t, "Mậu Tuất", p.Day.String())
+	assert.Equal(t, "Canh Thân", p.Hour.String())
+	assert.Equal(t, Mau, p.DayMaster())
+}
+
+func TestFourPillarsBeforeLunarNewYear(t *testing.T) {
+	// after Lập xuân but still lunar year Quý Mão
+	d := FromSolarTime(time.Date(2024, time.February, 8, 10, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, 2023, d.Year())
+	p := d.FourPillars()
+	assert.Equal(t, "Giáp Thìn", p.Year.String())
+	assert.Equal(t, "Bính Dần", p.Month.String())
+}
+
+func TestFourPillarsDayStartHour(t *testing.T) {
+	birth := time.Date(2024, time.February, 9, 23, 30, 0, 0, VietNamTimeZone)
+	p := FourPillarsOf(birth)
+	assert.Equal(t, "Quý Mão", p.Day.String())
+	assert.Equal(t, "Giáp Tý", p.Hour.String())
+
+	p = NewCalendar(WithDayStartHour(23)).FromSolarTime(birth).FourPillars()
+	assert.Equal(t, "Giáp Thìn", p.Day.String())
+	assert.Equal(t, "Giáp Tý", p.Hour.String())
+}
+
+func TestTenGodOf(t *testing.T) {
+	assert.Equal(t, TyKien, TenGodOf(Giap, Giap))
+	assert.Equal(t, KiepTai, TenGodOf(Giap, At))
+	assert.Equal(t, ThucThan, TenGodOf(Giap, Binh))
+	assert.Equal(t, ThuongQuan, TenGodOf(Giap, Dinh))
+	assert.Equal(t, ThienTai, TenGodOf(Giap, Mau))
+	assert.Equal(t, ChinhTai, TenGodOf(Giap, Ky))
+	assert.Equal(t, ThatSat, TenGodOf(Giap, Canh))
+	assert.Equal(t, ChinhQuan, TenGodOf(Giap, Tan))
+	assert.Equal(t, ThienAn, TenGodOf(Giap, Nham))
+	assert.Equal(t, ChinhAn, TenGodOf(Giap, Quy))
+	assert.Equal(t, ChinhQuan, TenGodOf(Quy, Mau))
+	assert.Equal(t, "Thất Sát", TenGodOf(Mau, Giap).String())
+}
+
+func TestFourPillarsTenGodsAndElements(t *testing.T) {
+	p := FourPillarsOf(time.Date(2024, time.February, 4, 16, 0, 0, 0, VietNamTimeZone))
+	// day master Mậu
+	assert.Equal(t, ThatSat, p.Year.TenGod)
+	// Thìn hides Mậu
+	assert.Equal(t, TyKien, p.Year.BranchTenGod)
+	assert.Equal(t, ThienAn, p.Month.TenGod)
+	assert.Equal(t, TyKien, p.Day.TenGod)
+	assert.Equal(t, ThucThan, p.Hour.TenGod)
+
+	// Giáp Thìn, Bính Dần, Mậu Tuất, Canh Thân
+	counts := p.ElementCounts()
+	assert.Equal(t, 2, counts[Moc])
+	assert.Equal(t, 1, counts[Hoa])
+	assert.Equal(t, 3, counts[Tho])
+	assert.Equal(t, 2, counts[Kim])
+	assert.Equal(t, 0, counts[Thuy])
+}
@@ -0,0 +1,29 @@
+// Package place holds geographic positions of observers,
+// with presets for Vietnamese cities
+package place
+
+import "fmt"
+
+// Place is a position on Earth
+type Place struct {
+	Name string
+	// Latitude in degrees, north is positive
+	Latitude float64
+	// Longitude in degrees, east is positive
+	Longitude float64
+}
+
+var (
+	HaNoi         = Place{Name: "Hà Nội", Latitude: 21.0285, Longitude: 105.8542}
+	Hue           = Place{Name: "Huế", Latitude: 16.4637, Longitude: 107.5909}
+	DaNang        = Place{Name: "Đà Nẵng", Latitude: 16.0544, Longitude: 108.2022}
+	HoChiMinhCity = Place{Name: "TP.HCM", Latitude: 10.7769, Longitude: 106.7009}
+	CanTho        = Place{Name: "Cần Thơ", Latitude: 10.0452, Longitude: 105.7469}
+)
+
+// Cities are the presets from north to south
+var Cities = []Place{HaNoi, Hue, DaNang, HoChiMinhCity, CanTho}
+
+func (p Place) String() string {
+	return fmt.Sprintf("%s (%.4f, %.4f)", p.Name, p.Latitude, p.Longitude)
+}
@@ -0,0 +1,15 @@
+package place
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCities(t *testing.T) {
+	assert.Len(t, Cities, 5)
+	for i := 1; i < len(Cities); i++ {
+		assert.Less(t, Cities[i].Latitude, Cities[i-1].Latitude)
+	}
+	assert.Equal(t, "Hà Nội (21.0285, 105.8542)", HaNoi.String())
+}
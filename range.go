@@ -0,0 +1,216 @@
+package vncalendar
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// Supported years of the solar date in its time zone, proleptic Gregorian
+// as time.Time. A lunar date is supported when its solar date is, so
+// the end of lunar year 999 and the end of lunar year 3000 are outside.
+// Within this range every day converts back and forth, and new moons of
+// StandardEngine are within 10 minutes of PrecisionEngine so results only
+// differ on borderline days, see BorderlineDays.
+// Functions returning an error reject other dates with *RangeError.
+// Date, FromSolarTime, Solar2lunar and Lunar2solar do not check the range
+// and are deprecated in favour of their Checked variants
+const (
+	MinSupportedYear = 1000
+	MaxSupportedYear = 3000
+)
+
+// ErrNotSupportedYearRange matches every *RangeError with errors.Is
+var ErrNotSupportedYearRange = errors.New("not supported year range")
+
+// RangeError is returned for a date outside the supported range,
+// Year is the solar year of the date or the year given when the date
+// cannot be computed
+type RangeError struct {
+	Year int
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%s: %d is not in %d-%d", ErrNotSupportedYearRange, e.Year, MinSupportedYear, MaxSupportedYear)
+}
+
+// Is reports whether target is ErrNotSupportedYearRange
+func (e *RangeError) Is(target error) bool {
+	return target == ErrNotSupportedYearRange
+}
+
+// first and last supported julian day numbers
+var (
+	minSupportedJDN = ProlepticGregorian.JDN(MinSupportedYear, 1, 1)
+	maxSupportedJDN = ProlepticGregorian.JDN(MaxSupportedYear, 12, 31)
+)
+
+// checkJDN is the supported range, every check ends here
+func checkJDN(jdn int) error {
+	if jdn < minSupportedJDN || jdn > maxSupportedJDN {
+		return &RangeError{Year: ProlepticGregorian.Date(jdn).Year}
+	}
+	return nil
+}
+
+// checkYear rejects a year that cannot have a supported day,
+// before computing its dates
+func checkYear(year int) error {
+	if year < MinSupportedYear-1 || year > MaxSupportedYear {
+		return &RangeError{Year: year}
+	}
+	return nil
+}
+
+// checkSolarTime returns d or *RangeError if its solar date is not supported
+func checkSolarTime(d VNDate) (VNDate, error) {
+	year, month, day := d.solarTime.Date()
+	if err := checkJDN(ProlepticGregorian.JDN(year, int(month), day)); err != nil {
+		return VNDate{}, err
+	}
+	return d, nil
+}
+
+// checkSolar validates a solar date in the calendar of the reform
+// with the errors of ParseDate
+func checkSolar(r Reform, year, month, day int) error {
+	if err := checkYear(year); err != nil {
+		return err
+	}
+	if month < 1 || month > 12 {
+		return ErrInvalidMonth
+	}
+	jdn := r.JDN(year, month, day)
+	if r.Date(jdn) != (SolarDate{Year: year, Month: month, Day: day}) {
+		return ErrInvalidDay
+	}
+	return checkJDN(jdn)
+}
+
+// checkLunar validates a lunar date with the errors of ParseLunarDate
+func (c converter) checkLunar(year, month, day int, leap bool) error {
+	_, err := c.checkedLunarJDN(year, month, day, leap)
+	return err
+}
+
+// checkedLunarJDN returns the julian day number of a valid lunar date
+func (c converter) checkedLunarJDN(year, month, day int, leap bool) (int, error) {
+	if err := checkYear(year); err != nil {
+		return 0, err
+	}
+	if month < 1 || month > 12 {
+		return 0, ErrInvalidMonth
+	}
+	if day < 1 || day > 31 {
+		return 0, ErrInvalidDay
+	}
+	jdn := c.lunarJDN(year, month, day, leap)
+	if jdn == 0 || c.lunarDate(jdn) != (LunarDate{Year: year, Month: month, Day: day, Leap: leap}) {
+		return 0, ErrInvalidDate
+	}
+	return jdn, checkJDN(jdn)
+}
+
+// BorderlineEvent is what decides the lunar month on a borderline day
+type BorderlineEvent int
+
+const (
+	// BorderlineNewMoon decides the first day of the month
+	BorderlineNewMoon BorderlineEvent = iota
+	// BorderlineMajorTerm decides whether the month is a leap month,
+	// a major term (trung khí) is close to midnight of the first day
+	BorderlineMajorTerm
+)
+
+var borderlineEventNames = []string{"new moon", "major term"}
+
+func (e BorderlineEvent) String() string {
+	return borderlineEventNames[mod(int(e), 2)]
+}
+
+// BorderlineDay is a lunar month whose conversion depends on an event
+// close to local midnight, so it may differ from other calendars
+// or change with a better ΔT
+type BorderlineDay struct {
+	Event BorderlineEvent
+	// Month is the lunar month starting at the new moon
+	Month LunarDate
+	// Time is the exact time of the event
+	Time time.Time
+	// Midnight is the local midnight closest to the event
+	Midnight time.Time
+}
+
+// Margin returns how far the event is from midnight
+func (d BorderlineDay) Margin() time.Duration {
+	margin := d.Time.Sub(d.Midnight)
+	if margin < 0 {
+		return -margin
+	}
+	return margin
+}
+
+// BorderlineDays lists lunar months from fromYear to toYear, both included,
+// where a new moon or a major term is within margin of local midnight
+func BorderlineDays(fromYear, toYear int, margin time.Duration) []BorderlineDay {
+	return borderlineDays(newConverter(defaultAstronomy(), defaultOffset()), VietNamTimeZone, fromYear, toYear, margin)
+}
+
+// BorderlineDays works as the package level BorderlineDays using the calendar settings
+func (c *Calendar) BorderlineDays(fromYear, toYear int, margin time.Duration) []BorderlineDay {
+	return borderlineDays(c.converter(), c.location, fromYear, toYear, margin)
+}
+
+func borderlineDays(c converter, loc *time.Location, fromYear, toYear int, margin time.Duration) []BorderlineDay {
+	const tropicalYear = 365.2422
+	var days []BorderlineDay
+	from := c.lunarJDN(fromYear, 1, 1, false)
+	to := c.lunarJDN(toYear+1, 1, 1, false)
+	k := int(math.Floor((float64(from)-2415021.076998695)/29.530588853)) - 1
+	for ; ; k++ {
+		jd := c.astronomy.newMoon(k)
+		day := c.localDay(jd)
+		if day < from {
+			continue
+		}
+		if day >= to {
+			break
+		}
+		month := c.lunarDate(day)
+		// midnight before or after the new moon
+		closest := day
+		if jd-c.midnight(day) > 0.5 {
+			closest++
+		}
+		if d := newBorderline(BorderlineNewMoon, month, jd, c.midnight(closest), loc); d.Margin() <= margin {
+			days = append(days, d)
+		}
+		// the leap month rule uses the sun longitude at midnight of the first day,
+		// in the same form as the conversion does
+		midnight := c.midnight(day)
+		longitude := c.astronomy.sunLongitude(midnight) * 180 / math.Pi
+		term := math.Round(longitude/30) * 30
+		termJD := midnight + (term-longitude)/360*tropicalYear
+		if d := newBorderline(BorderlineMajorTerm, month, termJD, midnight, loc); d.Margin() <= margin {
+			days = append(days, d)
+		}
+	}
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Time.Before(days[j].Time)
+	})
+	return days
+}
+
+func newBorderline(event BorderlineEvent, month LunarDate, jd, midnight float64, loc *time.Location) BorderlineDay {
+	month.Day = 1
+	return BorderlineDay{
+		Event:    event,
+		Month:    month,
+		Time:     astro.TimeFromJD(jd).In(loc),
+		Midnight: astro.TimeFromJD(midnight).In(loc).Round(time.Second),
+	}
+}
@@ -0,0 +1,175 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeError(t *testing.T) {
+	assert.NoError(t, checkYear(MinSupportedYear))
+	assert.NoError(t, checkYear(MaxSupportedYear))
+
+	var rangeErr *RangeError
+	_, err := ParseFromSolarString("3001-01-01", "2006-01-02")
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, 3001, rangeErr.Year)
+
+	_, err = FullMoonDate(999, 1, false)
+	assert.ErrorAs(t, err, &rangeErr)
+
+	valid, _ := Validate(3001, 1, 1)
+	assert.False(t, valid)
+	valid, d := Validate(1000, 1, 1)
+	assert.True(t, valid)
+	assert.Equal(t, LunarDate{Year: 1000, Month: 1, Day: 1}, d.LunarDate())
+}
+
+func TestCheckedConstructors(t *testing.T) {
+	var rangeErr *RangeError
+	d, err := CheckedDate(2025, time.January, 29, 5, 0, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 1, Day: 1}, d.LunarDate())
+	_, err = CheckedDate(999, time.December, 31, 0, 0, 0, 0)
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, 999, rangeErr.Year)
+	// the year is the one in Vietnam
+	_, err = CheckedFromSolarTime(time.Date(3000, time.December, 31, 20, 0, 0, 0, time.UTC))
+	assert.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, 3001, rangeErr.Year)
+	_, err = ParseFromSolarString("3000-12-31 20:00:00 +0000 UTC", "")
+	assert.ErrorAs(t, err, &rangeErr)
+	_, err = CheckedFromSolarTime(time.Date(999, time.December, 31, 20, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	c := NewCalendar(WithLocation(time.UTC))
+	_, err = c.CheckedFromSolarTime(time.Date(3000, time.December, 31, 20, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = c.CheckedDate(3001, time.January, 1, 0, 0, 0, 0)
+	assert.ErrorAs(t, err, &rangeErr)
+
+	lunar, err := CheckedSolar2lunar(2025, 1, 29, TimeZoneOffset)
+	assert.NoError(t, err)
+	assert.Equal(t, LunarDate{Year: 2025, Month: 1, Day: 1}, lunar)
+	_, err = CheckedSolar2lunar(3001, 1, 1, TimeZoneOffset)
+	assert.ErrorAs(t, err, &rangeErr)
+	_, err = CheckedSolar2lunar(2025, 13, 1, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+	_, err = CheckedSolar2lunar(2025, 2, 29, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidDay)
+	// the days skipped by the 1582 reform, valid when proleptic
+	_, err = CheckedSolar2lunar(1582, 10, 10, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidDay)
+	_, err = NewCalendar(WithReform(ProlepticGregorian)).CheckedSolar2lunar(1582, 10, 10)
+	assert.NoError(t, err)
+
+	solar, err := CheckedLunar2solar(2025, 6, 1, true, TimeZoneOffset)
+	assert.NoError(t, err)
+	assert.Equal(t, SolarDate{Year: 2025, Month: 7, Day: 25}, solar)
+	_, err = CheckedLunar2solar(2024, 4, 1, true, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidDate)
+	_, err = CheckedLunar2solar(2025, 0, 1, false, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+	_, err = CheckedLunar2solar(2025, 1, 32, false, TimeZoneOffset)
+	assert.ErrorIs(t, err, ErrInvalidDay)
+	_, err = NewCalendar().CheckedLunar2solar(999, 1, 1, false)
+	assert.ErrorAs(t, err, &rangeErr)
+}
+
+func TestSupportedRangeEntryPoints(t *testing.T) {
+	// every entry point accepts the same days, the solar date decides
+	// at both ends of the lunar years 999 and 3000
+	c := NewCalendar(WithReform(ProlepticGregorian))
+	for _, edge := range []int{minSupportedJDN, maxSupportedJDN + 1} {
+		for jdn := edge - 40; jdn < edge+40; jdn++ {
+			supported := jdn >= minSupportedJDN && jdn <= maxSupportedJDN
+			d := FromJulianDay(JulianDay(jdn))
+			lunar := d.LunarDate()
+			solar := ProlepticGregorian.Date(jdn)
+
+			_, err := CheckedFromSolarTime(d.SolarTime())
+			assert.Equal(t, supported, err == nil, "%v", solar)
+			_, err = ParseLunarDate(d.Format(""), lunar.Leap)
+			assert.Equal(t, supported, err == nil, "%v", lunar)
+			_, err = c.CheckedSolar2lunar(solar.Year, solar.Month, solar.Day)
+			assert.Equal(t, supported, err == nil, "%v", solar)
+			_, err = c.CheckedLunar2solar(lunar.Year, lunar.Month, lunar.Day, lunar.Leap)
+			assert.Equal(t, supported, err == nil, "%v", lunar)
+			valid, _ := Validate(lunar.Year, lunar.Month, lunar.Day)
+			assert.Equal(t, supported && !lunar.Leap, valid, "%v", lunar)
+			if !supported {
+				assert.ErrorIs(t, err, ErrNotSupportedYearRange)
+			}
+		}
+	}
+
+	d, err := ParseDate("3000-11-01")
+	require.NoError(t, err)
+	_, err = CheckedFromSolarTime(d.SolarTime())
+	assert.NoError(t, err)
+	var rangeErr *RangeError
+	_, err = ParseDate("3000-12-01")
+	require.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, 3001, rangeErr.Year)
+	_, err = ParseDate("0999-12-01")
+	assert.NoError(t, err)
+	_, err = ParseDate("0998-12-01")
+	assert.ErrorIs(t, err, ErrNotSupportedYearRange)
+}
+
+func TestSupportedRangeRoundTrip(t *testing.T) {
+	first := jdFromDate(1, 1, MinSupportedYear)
+	last := jdFromDate(31, 12, MaxSupportedYear)
+	for jd := first; jd <= last; jd += 5 {
+		solar := jdToDate(jd)
+		lunar := Solar2lunar(solar.Year, solar.Month, solar.Day, TimeZoneOffset)
+		if !assert.True(t, lunar.Day >= 1 && lunar.Day <= 30, "%v: %v", solar, lunar) {
+			return
+		}
+		if !assert.Equal(t, solar, Lunar2solar(lunar.Year, lunar.Month, lunar.Day, lunar.Leap, TimeZoneOffset)) {
+			return
+		}
+	}
+}
+
+func TestTetBefore1900(t *testing.T) {
+	assert.Equal(t, SolarDate{Year: 1900, Month: 1, Day: 31}, Lunar2solar(1900, 1, 1, false, TimeZoneOffset))
+	assert.Equal(t, SolarDate{Year: 1850, Month: 2, Day: 12}, Lunar2solar(1850, 1, 1, false, TimeZoneOffset))
+	assert.Equal(t, SolarDate{Year: 1800, Month: 1, Day: 25}, Lunar2solar(1800, 1, 1, false, TimeZoneOffset))
+	assert.Equal(t, LunarDate{Year: 1899, Month: 11, Day: 13}, Solar2lunar(1899, 12, 15, TimeZoneOffset))
+}
+
+func TestLeapMonth12(t *testing.T) {
+	leap := Solar2lunar(1404, 1, 13, TimeZoneOffset)
+	assert.Equal(t, LunarDate{Year: 1403, Month: 12, Day: 1, Leap: true}, leap)
+	assert.Equal(t, SolarDate{Year: 1404, Month: 1, Day: 13}, Lunar2solar(1403, 12, 1, true, TimeZoneOffset))
+}
+
+func TestBorderlineDays(t *testing.T) {
+	days := BorderlineDays(1900, 2100, 5*time.Minute)
+	assert.NotEmpty(t, days)
+	var newMoon1944, majorTerm1938 bool
+	for i, d := range days {
+		assert.LessOrEqual(t, d.Margin(), 5*time.Minute)
+		assert.Equal(t, 0, d.Midnight.Hour())
+		assert.Equal(t, 1, d.Month.Day)
+		assert.True(t, d.Month.Year >= 1900 && d.Month.Year <= 2100)
+		if i > 0 {
+			assert.False(t, d.Time.Before(days[i-1].Time))
+		}
+		switch {
+		case d.Event == BorderlineNewMoon && d.Month == LunarDate{Year: 1944, Month: 5, Day: 1}:
+			newMoon1944 = true
+			assert.Less(t, d.Margin(), 2*time.Minute)
+		case d.Event == BorderlineMajorTerm && d.Month == LunarDate{Year: 1938, Month: 8, Day: 1, Leap: true}:
+			majorTerm1938 = true
+		}
+	}
+	assert.True(t, newMoon1944)
+	assert.True(t, majorTerm1938)
+
+	assert.Empty(t, BorderlineDays(2024, 2024, time.Minute))
+	assert.Equal(t, "major term", BorderlineMajorTerm.String())
+}
@@ -0,0 +1,89 @@
+package vncalendar
+
+import (
+	"fmt"
+	"math"
+)
+
+// Reform is the julian day number of the first day of the Gregorian calendar,
+// solar dates before it are in the Julian calendar
+type Reform int
+
+const (
+	// ProlepticGregorian uses the Gregorian calendar for all dates as time.Time does
+	ProlepticGregorian Reform = math.MinInt
+	// ProlepticJulian uses the Julian calendar for all dates
+	ProlepticJulian Reform = math.MaxInt
+	// Reform1582 switches from 4 to 15 October 1582 as decreed by Gregory XIII,
+	// the default of SolarDate conversions
+	Reform1582 Reform = 2299161
+)
+
+// ReformAt returns the reform where the Gregorian calendar starts
+// at the given Gregorian date, e.g. 1752-09-14 in Great Britain
+func ReformAt(year, month, day int) Reform {
+	return Reform(ProlepticGregorian.JDN(year, month, day))
+}
+
+// JDN returns the julian day number of a solar date
+func (r Reform) JDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	jd := day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+	if jd < int(r) {
+		jd = day + (153*m+2)/5 + 365*y + y/4 - 32083
+	}
+	return jd
+}
+
+// Date returns the solar date of a julian day number
+func (r Reform) Date(jdn int) SolarDate {
+	var b, c int
+	if jdn >= int(r) {
+		a := jdn + 32044
+		b = (4*a + 3) / 146097
+		c = a - (b*146097)/4
+	} else {
+		c = jdn + 32082
+	}
+	d := (4*c + 3) / 1461
+	e := c - (1461*d)/4
+	m := (5*e + 2) / 153
+	return SolarDate{
+		Day:   e - (153*m+2)/5 + 1,
+		Month: m + 3 - 12*(m/10),
+		Year:  b*100 + d - 4800 + m/10,
+	}
+}
+
+// JulianDate is a solar date in the Julian calendar,
+// e.g. as written in sources before the Gregorian reform
+type JulianDate struct {
+	Year, Month, Day int
+}
+
+// JulianDateOf returns the Julian calendar date of a julian day number
+func JulianDateOf(jdn int) JulianDate {
+	d := ProlepticJulian.Date(jdn)
+	return JulianDate(d)
+}
+
+// JDN returns the julian day number of the date
+func (d JulianDate) JDN() int {
+	return ProlepticJulian.JDN(d.Year, d.Month, d.Day)
+}
+
+// Gregorian returns the same day in the proleptic Gregorian calendar
+func (d JulianDate) Gregorian() SolarDate {
+	return ProlepticGregorian.Date(d.JDN())
+}
+
+func (d JulianDate) String() string {
+	return fmt.Sprintf("%s-%s-%s", paddYear(d.Year), padd(d.Month), padd(d.Day))
+}
+
+// JulianDate returns the solar date of the lunar day in the Julian calendar
+func (t VNDate) JulianDate() JulianDate {
+	return JulianDateOf(t.JDN())
+}
@@ -0,0 +1,56 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReform(t *testing.T) {
+	assert.Equal(t, 2299160, Reform1582.JDN(1582, 10, 4))
+	assert.Equal(t, 2299161, Reform1582.JDN(1582, 10, 15))
+	assert.Equal(t, 2299150, ProlepticGregorian.JDN(1582, 10, 4))
+	assert.Equal(t, 2451558, ProlepticJulian.JDN(2000, 1, 1))
+	assert.Equal(t, SolarDate{Year: 1999, Month: 12, Day: 19}, ProlepticJulian.Date(2451545))
+	assert.Equal(t, SolarDate{Year: 1582, Month: 10, Day: 14}, ProlepticGregorian.Date(2299160))
+
+	// Great Britain, 2 September 1752 was followed by 14 September
+	britain := ReformAt(1752, 9, 14)
+	jdn := britain.JDN(1752, 9, 2)
+	assert.Equal(t, SolarDate{Year: 1752, Month: 9, Day: 2}, britain.Date(jdn))
+	assert.Equal(t, SolarDate{Year: 1752, Month: 9, Day: 14}, britain.Date(jdn+1))
+	assert.Equal(t, SolarDate{Year: 1700, Month: 2, Day: 29}, britain.Date(britain.JDN(1700, 2, 29)))
+
+	for jdn := jdFromDate(1, 1, MinSupportedYear); jdn < jdFromDate(1, 1, MaxSupportedYear); jdn += 97 {
+		for _, r := range []Reform{ProlepticGregorian, ProlepticJulian, Reform1582, britain} {
+			d := r.Date(jdn)
+			assert.Equal(t, jdn, r.JDN(d.Year, d.Month, d.Day))
+		}
+	}
+}
+
+func TestJulianDate(t *testing.T) {
+	d := JulianDateOf(2451545)
+	assert.Equal(t, JulianDate{Year: 1999, Month: 12, Day: 19}, d)
+	assert.Equal(t, "1999-12-19", d.String())
+	assert.Equal(t, 2451545, d.JDN())
+	assert.Equal(t, SolarDate{Year: 2000, Month: 1, Day: 1}, d.Gregorian())
+}
+
+func TestCalendarWithReform(t *testing.T) {
+	gregorian := NewCalendar(WithReform(ProlepticGregorian))
+	// 1 March 1500 Gregorian is 20 February in the Julian calendar
+	assert.Equal(t, Solar2lunar(1500, 2, 20, TimeZoneOffset), gregorian.Solar2lunar(1500, 3, 1))
+	lunar := gregorian.Solar2lunar(1500, 3, 1)
+	assert.Equal(t, SolarDate{Year: 1500, Month: 3, Day: 1}, gregorian.Lunar2solar(lunar.Year, lunar.Month, lunar.Day, lunar.Leap))
+	assert.Equal(t, SolarDate{Year: 1500, Month: 2, Day: 20}, Lunar2solar(lunar.Year, lunar.Month, lunar.Day, lunar.Leap, TimeZoneOffset))
+	// after the reform both are the same
+	assert.Equal(t, Solar2lunar(2024, 2, 10, TimeZoneOffset), gregorian.Solar2lunar(2024, 2, 10))
+
+	// VNDate is proleptic Gregorian as time.Time
+	d := FromSolarTime(time.Date(1500, time.March, 1, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, lunar, d.LunarDate())
+	assert.Equal(t, JulianDate{Year: 1500, Month: 2, Day: 20}, d.JulianDate())
+	assert.Equal(t, lunar, NewCalendar().FromSolarTime(d.SolarTime()).LunarDate())
+}
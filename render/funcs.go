@@ -0,0 +1,130 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+)
+
+// DateFuncs are template functions on dates for both text/template and
+// html/template, e.g. template.New("page").Funcs(render.DateFuncs).
+// Dates are a vncalendar.VNDate, a *vncalendar.VNDate or a time.Time
+// taken at its date in its own location.
+//
+//	lunar DATE                   the vncalendar.LunarDate
+//	lunarFormat LAYOUT DATE      VNDate.Format of the lunar date, "" is YYYY-MM-DD
+//	lunarLabel DATE              the day, or day/month on the first day as 1/6n
+//	canchi year|month|day DATE   the can chi of the lunar year, month or day
+//	solarTerm DATE               the solar term name
+//	holidayNames DATE            names of the holidays on the date
+//	isMung1 DATE                 the first day of a lunar month
+//	isRam DATE                   the fifteenth day of a lunar month
+//	monthGrid DATE [sunday]      weeks of the solar month, nil outside the month
+//	weekdays [sunday]            short weekday names in the order of monthGrid
+var DateFuncs = map[string]any{
+	"lunar": func(v any) (vncalendar.LunarDate, error) {
+		d, err := toDate(v)
+		return d.LunarDate(), err
+	},
+	"lunarFormat": func(layout string, v any) (string, error) {
+		d, err := toDate(v)
+		if err != nil {
+			return "", err
+		}
+		return d.Format(layout), nil
+	},
+	"lunarLabel": func(v any) (string, error) {
+		d, err := toDate(v)
+		return LunarLabel(d.LunarDate()), err
+	},
+	"canchi": func(kind string, v any) (string, error) {
+		d, err := toDate(v)
+		if err != nil {
+			return "", err
+		}
+		switch kind {
+		case "year":
+			return d.YearCanChi().String(), nil
+		case "month":
+			return d.MonthCanChi().String(), nil
+		case "day":
+			return d.DayCanChi().String(), nil
+		}
+		return "", fmt.Errorf("render: canchi of year, month or day, not %q", kind)
+	},
+	"solarTerm": func(v any) (string, error) {
+		d, err := toDate(v)
+		if err != nil {
+			return "", err
+		}
+		return d.SolarTerm().String(), nil
+	},
+	"holidayNames": func(v any) ([]string, error) {
+		d, err := toDate(v)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, h := range d.Holidays() {
+			names = append(names, h.Name)
+		}
+		return names, nil
+	},
+	"isMung1": func(v any) (bool, error) {
+		d, err := toDate(v)
+		return err == nil && d.IsTheFirst(), err
+	},
+	"isRam": func(v any) (bool, error) {
+		d, err := toDate(v)
+		return err == nil && d.IsTheFifteen(), err
+	},
+	"monthGrid": func(v any, weekStart ...string) ([][]*vncalendar.VNDate, error) {
+		d, err := toDate(v)
+		if err != nil {
+			return nil, err
+		}
+		start, err := parseWeekStart(weekStart)
+		if err != nil {
+			return nil, err
+		}
+		solar := d.SolarTime()
+		return vncalendar.MonthGrid(vncalendar.GetMonthDates(solar.Year(), solar.Month()), start), nil
+	},
+	"weekdays": func(weekStart ...string) ([]string, error) {
+		start, err := parseWeekStart(weekStart)
+		if err != nil {
+			return nil, err
+		}
+		var names []string
+		for _, day := range vncalendar.WeekDays(start) {
+			names = append(names, weekdayShortNames[day])
+		}
+		return names, nil
+	},
+}
+
+// toDate converts the date argument of DateFuncs
+func toDate(v any) (vncalendar.VNDate, error) {
+	switch d := v.(type) {
+	case vncalendar.VNDate:
+		return d, nil
+	case *vncalendar.VNDate:
+		if d != nil {
+			return *d, nil
+		}
+	case time.Time:
+		return vncalendar.CheckedFromSolarTime(time.Date(d.Year(), d.Month(), d.Day(), 12, 0, 0, 0, vncalendar.VietNamTimeZone))
+	}
+	return vncalendar.VNDate{}, fmt.Errorf("render: not a date %v", v)
+}
+
+func parseWeekStart(args []string) (time.Weekday, error) {
+	if len(args) == 0 || (len(args) == 1 && args[0] == "monday") {
+		return time.Monday, nil
+	}
+	if len(args) == 1 && args[0] == "sunday" {
+		return time.Sunday, nil
+	}
+	return 0, fmt.Errorf("render: week starts on monday or sunday, not %q", args)
+}
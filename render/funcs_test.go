@@ -0,0 +1,83 @@
+package render
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanng822/vncalendar"
+)
+
+func execText(t *testing.T, text string, data any) string {
+	t.Helper()
+	tmpl, err := texttemplate.New("test").Funcs(DateFuncs).Parse(text)
+	require.NoError(t, err)
+	var b strings.Builder
+	require.NoError(t, tmpl.Execute(&b, data))
+	return b.String()
+}
+
+func TestDateFuncs(t *testing.T) {
+	tet := time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC)
+	vn := vncalendar.FromSolarTime(time.Date(2025, 1, 29, 12, 0, 0, 0, vncalendar.VietNamTimeZone))
+	for _, date := range []any{tet, vn, &vn} {
+		data := map[string]any{"Date": date}
+		assert.Equal(t, "1/1/2025", execText(t, `{{with lunar .Date}}{{.Day}}/{{.Month}}/{{.Year}}{{end}}`, data))
+		assert.Equal(t, "01/01/2025", execText(t, `{{.Date | lunarFormat "%[3]s/%[2]s/%[1]s"}}`, data))
+		assert.Equal(t, "2025-01-01", execText(t, `{{lunarFormat "" .Date}}`, data))
+		assert.Equal(t, "1/1", execText(t, `{{lunarLabel .Date}}`, data))
+		assert.Equal(t, "Ất Tỵ Mậu Tuất", execText(t, `{{canchi "year" .Date}} {{canchi "day" .Date}}`, data))
+		assert.Equal(t, "Tết Nguyên Đán", execText(t, `{{range holidayNames .Date}}{{.}}{{end}}`, data))
+		assert.Equal(t, "true false", execText(t, `{{isMung1 .Date}} {{isRam .Date}}`, data))
+		assert.NotEmpty(t, execText(t, `{{solarTerm .Date}}`, data))
+	}
+	// a date is taken in its own location, 23:00 in Vietnam is the next day
+	late := time.Date(2025, 2, 12, 23, 0, 0, 0, time.FixedZone("", -5*3600))
+	assert.Equal(t, "true", execText(t, `{{isRam .}}`, late))
+	// a leap month
+	assert.Equal(t, "1/6n", execText(t, `{{lunarLabel .}}`, time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestDateFuncsMonthGrid(t *testing.T) {
+	text := `{{range weekdays}}{{.}} {{end}}
+{{range monthGrid .}}{{range .}}{{if .}}{{lunarLabel .}}{{else}}-{{end}} {{end}}
+{{end}}`
+	out := execText(t, text, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 6)
+	assert.Equal(t, "T2 T3 T4 T5 T6 T7 CN", strings.TrimSpace(lines[0]))
+	// 1 January 2025 is a Wednesday and the 2nd of the 12th lunar month
+	assert.Equal(t, "- - 2 3 4 5 6", strings.TrimSpace(lines[1]))
+	assert.Equal(t, "28 29 1/1 2 3 - -", strings.TrimSpace(lines[5]))
+
+	out = execText(t, `{{range weekdays "sunday"}}{{.}} {{end}}|{{with index (monthGrid . "sunday") 0}}{{index . 3 | lunarLabel}}{{end}}`, time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "CN T2 T3 T4 T5 T6 T7 |2", out)
+}
+
+func TestDateFuncsHTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(DateFuncs).Parse(
+		`<td title="{{canchi "day" .}}">{{lunarLabel .}}</td>`))
+	var b strings.Builder
+	require.NoError(t, tmpl.Execute(&b, time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, `<td title="Mậu Tuất">1/1</td>`, b.String())
+}
+
+func TestDateFuncsErrors(t *testing.T) {
+	for _, text := range []string{
+		`{{lunar .}}`,
+		`{{canchi "hour" .Date}}`,
+		`{{monthGrid .Date "friday"}}`,
+		`{{weekdays "monday" "sunday"}}`,
+	} {
+		tmpl := texttemplate.Must(texttemplate.New("test").Funcs(DateFuncs).Parse(text))
+		err := tmpl.Execute(&strings.Builder{}, map[string]any{"Date": time.Now()})
+		assert.Error(t, err, text)
+	}
+	var nilDate *vncalendar.VNDate
+	tmpl := texttemplate.Must(texttemplate.New("test").Funcs(DateFuncs).Parse(`{{isRam .}}`))
+	assert.Error(t, tmpl.Execute(&strings.Builder{}, nilDate))
+}
@@ -0,0 +1,407 @@
+package render
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+)
+
+// PageSize is the paper size in millimeters
+type PageSize struct {
+	Name          string
+	Width, Height float64
+}
+
+var (
+	A3     = PageSize{Name: "A3", Width: 297, Height: 420}
+	A4     = PageSize{Name: "A4", Width: 210, Height: 297}
+	A5     = PageSize{Name: "A5", Width: 148, Height: 210}
+	Letter = PageSize{Name: "Letter", Width: 215.9, Height: 279.4}
+)
+
+// Landscape returns the size turned sideways
+func (s PageSize) Landscape() PageSize {
+	return PageSize{Name: s.Name + " landscape", Width: s.Height, Height: s.Width}
+}
+
+// Document is a printable calendar of a year, one page per month
+type Document struct {
+	Title string
+	Size  PageSize
+	Pages []Page
+}
+
+// Page is a month grid with its events, sizes are in millimeters
+type Page struct {
+	Title string
+	// Subtitle gives the lunar years of a solar month
+	// or the solar dates of a lunar month
+	Subtitle      string
+	Size          PageSize
+	Margin        float64
+	Weekdays      []Weekday
+	Weeks         [][]Cell
+	Holidays      []Event
+	SolarTerms    []Event
+	GridTop       float64
+	FooterTop     float64
+	CellWidth     float64
+	CellHeight    float64
+	HeaderHeight  float64
+	ContentWidth  float64
+	ContentHeight float64
+}
+
+// Weekday is a column header of the grid
+type Weekday struct {
+	Name   string
+	Sunday bool
+	X      float64
+}
+
+// Cell is a day of the grid, Empty before the first and after the last day
+type Cell struct {
+	Empty bool
+	Date  vncalendar.VNDate
+	Solar int
+	// Lunar is the lunar day, with the month on mùng 1
+	Lunar     string
+	DayCanChi string
+	Sunday    bool
+	FirstDay  bool
+	FullMoon  bool
+	Holidays  []string
+	// SolarTerm is the name of the solar term starting on the day
+	SolarTerm string
+	// X and Y are the top left corner of the cell on the page
+	X, Y float64
+}
+
+// Class returns CSS classes of the cell
+func (c Cell) Class() string {
+	var classes []string
+	for _, class := range []struct {
+		name string
+		ok   bool
+	}{
+		{"empty", c.Empty}, {"sunday", c.Sunday}, {"first-day", c.FirstDay},
+		{"full-moon", c.FullMoon}, {"holiday", len(c.Holidays) > 0}, {"solar-term", c.SolarTerm != ""},
+	} {
+		if class.ok {
+			classes = append(classes, class.name)
+		}
+	}
+	return strings.Join(classes, " ")
+}
+
+// Event is a holiday or a solar term listed under the grid
+type Event struct {
+	Date string
+	Name string
+}
+
+//go:embed templates
+var templateFS embed.FS
+
+// Funcs are available in the templates, arithmetic of millimeters
+// accepting ints and floats
+var Funcs = template.FuncMap{
+	"add": func(a any, b ...any) (float64, error) {
+		sum, err := toFloat(a)
+		if err != nil {
+			return 0, err
+		}
+		for _, v := range b {
+			x, err := toFloat(v)
+			if err != nil {
+				return 0, err
+			}
+			sum += x
+		}
+		return millimeters(sum), nil
+	},
+	"sub": func(a, b any) (float64, error) {
+		x, y, err := toFloats(a, b)
+		return millimeters(x - y), err
+	},
+	"mul": func(a, b any) (float64, error) {
+		x, y, err := toFloats(a, b)
+		return millimeters(x * y), err
+	},
+}
+
+// millimeters rounds to a hundredth of a millimeter
+func millimeters(x float64) float64 {
+	return math.Round(x*100) / 100
+}
+
+func toFloat(v any) (float64, error) {
+	switch x := v.(type) {
+	case int:
+		return float64(x), nil
+	case float64:
+		return x, nil
+	}
+	return 0, fmt.Errorf("render: not a number %v", v)
+}
+
+func toFloats(a, b any) (float64, float64, error) {
+	x, err := toFloat(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	y, err := toFloat(b)
+	return x, y, err
+}
+
+var (
+	defaultHTML = template.Must(template.New("calendar.html.tmpl").Funcs(Funcs).ParseFS(templateFS, "templates/calendar.html.tmpl"))
+	defaultSVG  = template.Must(template.New("month.svg.tmpl").Funcs(Funcs).ParseFS(templateFS, "templates/month.svg.tmpl"))
+)
+
+// Printer renders printable calendars as self-contained HTML and SVG
+type Printer struct {
+	size      PageSize
+	margin    float64
+	weekStart time.Weekday
+	html      *template.Template
+	svg       *template.Template
+}
+
+type PrinterOption func(p *Printer)
+
+// WithPageSize sets the paper size, default is A4
+func WithPageSize(size PageSize) PrinterOption {
+	return func(p *Printer) {
+		p.size = size
+	}
+}
+
+// WithMargin sets the page margin in millimeters, default is 10
+func WithMargin(margin float64) PrinterOption {
+	return func(p *Printer) {
+		p.margin = margin
+	}
+}
+
+// WithPrintWeekStart sets the first day of the week, default is time.Monday.
+// Values outside Sunday to Saturday are taken modulo 7
+func WithPrintWeekStart(day time.Weekday) PrinterOption {
+	return func(p *Printer) {
+		p.weekStart = day
+	}
+}
+
+// WithHTMLTemplate replaces the HTML template, it is executed with a Document.
+// Parse it with Funcs to use the same helpers as the default one
+func WithHTMLTemplate(t *template.Template) PrinterOption {
+	return func(p *Printer) {
+		p.html = t
+	}
+}
+
+// WithSVGTemplate replaces the SVG template, it is executed with a Page
+func WithSVGTemplate(t *template.Template) PrinterOption {
+	return func(p *Printer) {
+		p.svg = t
+	}
+}
+
+// NewPrinter returns a Printer of A4 pages with the default templates
+func NewPrinter(options ...PrinterOption) *Printer {
+	p := &Printer{size: A4, margin: 10, weekStart: time.Monday, html: defaultHTML, svg: defaultSVG}
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// Year returns a document of the solar year from GetYearMonthDates
+func (p *Printer) Year(months map[time.Month][]vncalendar.VNDate) Document {
+	var doc Document
+	for _, month := range vncalendar.Months {
+		dates := months[month]
+		if len(dates) == 0 {
+			continue
+		}
+		first, last := dates[0], dates[len(dates)-1]
+		if doc.Title == "" {
+			doc.Title = fmt.Sprintf("Lịch năm %d", first.SolarTime().Year())
+		}
+		subtitle := "Năm " + first.YearCanChi().String()
+		if last.Year() != first.Year() {
+			subtitle += " - " + last.YearCanChi().String()
+		}
+		title := fmt.Sprintf("Tháng %d năm %d", month, first.SolarTime().Year())
+		doc.Pages = append(doc.Pages, p.page(title, subtitle, dates))
+	}
+	doc.Size = p.size
+	return doc
+}
+
+// LunarYear returns a document of the lunar year, one page per lunar month
+// including the leap month, from Tết to the day before the next Tết.
+// It returns *vncalendar.RangeError if the year is not supported
+func (p *Printer) LunarYear(lunarYear int) (Document, error) {
+	if lunarYear == vncalendar.MaxSupportedYear {
+		// the end is in the solar year after the supported range
+		return Document{}, &vncalendar.RangeError{Year: lunarYear}
+	}
+	// Tết from its julian day number, proleptic Gregorian as VNDate
+	d, err := vncalendar.ParseDate(fmt.Sprintf("%04d-01-01", lunarYear))
+	if err != nil {
+		return Document{}, err
+	}
+	doc := Document{Title: fmt.Sprintf("Lịch năm %s %d", vncalendar.YearCanChi(lunarYear), lunarYear), Size: p.size}
+	var month []vncalendar.VNDate
+	flush := func() {
+		if len(month) == 0 {
+			return
+		}
+		first, last := month[0], month[len(month)-1]
+		name := fmt.Sprintf("Tháng %d", first.LunarDate().Month)
+		if first.LunarDate().Leap {
+			name += " nhuận"
+		}
+		title := fmt.Sprintf("%s năm %s", name, first.YearCanChi())
+		subtitle := fmt.Sprintf("%s - %s", first.SolarTime().Format("02/01/2006"), last.SolarTime().Format("02/01/2006"))
+		doc.Pages = append(doc.Pages, p.page(title, subtitle, month))
+		month = nil
+	}
+	for ; d.Year() == lunarYear; d = d.NextDay() {
+		if d.Day() == 1 && len(month) > 0 {
+			flush()
+		}
+		month = append(month, d)
+	}
+	flush()
+	return doc, nil
+}
+
+// HTML writes the document as a self-contained HTML page
+func (p *Printer) HTML(w io.Writer, doc Document) error {
+	return p.html.Execute(w, doc)
+}
+
+// SVG writes a page as a self-contained SVG image
+func (p *Printer) SVG(w io.Writer, page Page) error {
+	return p.svg.Execute(w, page)
+}
+
+// page lays out consecutive dates in a grid
+func (p *Printer) page(title, subtitle string, dates []vncalendar.VNDate) Page {
+	const titleHeight, headerHeight, footerHeight = 24.0, 8.0, 30.0
+	page := Page{
+		Title:        title,
+		Subtitle:     subtitle,
+		Size:         p.size,
+		Margin:       p.margin,
+		HeaderHeight: headerHeight,
+	}
+	page.ContentWidth = p.size.Width - 2*p.margin
+	page.ContentHeight = p.size.Height - 2*p.margin
+	page.CellWidth = millimeters(page.ContentWidth / 7)
+	page.GridTop = p.margin + titleHeight + headerHeight
+	if len(dates) == 0 {
+		return page
+	}
+
+	terms := solarTermDays(dates)
+	for _, days := range vncalendar.MonthGrid(dates, p.weekStart) {
+		week := make([]Cell, len(days))
+		for i, d := range days {
+			if d == nil {
+				week[i] = Cell{Empty: true}
+			} else {
+				week[i] = newCell(*d, terms)
+			}
+		}
+		page.Weeks = append(page.Weeks, week)
+	}
+	gridHeight := page.ContentHeight - titleHeight - headerHeight - footerHeight
+	page.CellHeight = millimeters(gridHeight / float64(len(page.Weeks)))
+	page.FooterTop = page.GridTop + gridHeight
+
+	for i, day := range vncalendar.WeekDays(p.weekStart) {
+		page.Weekdays = append(page.Weekdays, Weekday{
+			Name:   weekdayShortNames[day],
+			Sunday: day == time.Sunday,
+			X:      millimeters(p.margin + float64(i)*page.CellWidth),
+		})
+	}
+	for row, week := range page.Weeks {
+		for col := range week {
+			cell := &page.Weeks[row][col]
+			cell.X = millimeters(p.margin + float64(col)*page.CellWidth)
+			cell.Y = millimeters(page.GridTop + float64(row)*page.CellHeight)
+			for _, name := range cell.Holidays {
+				page.Holidays = append(page.Holidays, Event{Date: cell.Date.SolarTime().Format("02/01"), Name: name})
+			}
+		}
+	}
+	for _, e := range sortedTerms(terms) {
+		page.SolarTerms = append(page.SolarTerms, Event{Date: e.Time.Format("02/01 15:04"), Name: e.Term.String()})
+	}
+	return page
+}
+
+func newCell(d vncalendar.VNDate, terms map[string]vncalendar.SolarTermEvent) Cell {
+	cell := Cell{
+		Date:      d,
+		Solar:     d.SolarTime().Day(),
+		Lunar:     LunarLabel(d.LunarDate()),
+		DayCanChi: d.DayCanChi().String(),
+		Sunday:    d.SolarTime().Weekday() == time.Sunday,
+		FirstDay:  d.Day() == 1,
+		FullMoon:  d.Day() == 15,
+	}
+	for _, h := range d.Holidays() {
+		cell.Holidays = append(cell.Holidays, h.Name)
+	}
+	if e, ok := terms[dayKey(d.SolarTime())]; ok {
+		cell.SolarTerm = e.Term.String()
+	}
+	return cell
+}
+
+// solarTermDays returns the solar terms starting within the dates by day,
+// computed by the calendar of the dates
+func solarTermDays(dates []vncalendar.VNDate) map[string]vncalendar.SolarTermEvent {
+	first, last := dayKey(dates[0].SolarTime()), dayKey(dates[len(dates)-1].SolarTime())
+	terms := map[string]vncalendar.SolarTermEvent{}
+	years := map[int]bool{}
+	for _, d := range dates {
+		if year := d.SolarTime().Year(); !years[year] {
+			years[year] = true
+			for _, e := range d.SolarTerms() {
+				if key := dayKey(e.Time); key >= first && key <= last {
+					terms[key] = e
+				}
+			}
+		}
+	}
+	return terms
+}
+
+// sortedTerms returns the solar terms in time order
+func sortedTerms(terms map[string]vncalendar.SolarTermEvent) []vncalendar.SolarTermEvent {
+	events := make([]vncalendar.SolarTermEvent, 0, len(terms))
+	for _, e := range terms {
+		events = append(events, e)
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events
+}
+
+func dayKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,198 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vanng822/vncalendar"
+)
+
+func TestPrinterYear(t *testing.T) {
+	doc := NewPrinter().Year(vncalendar.GetYearMonthDates(2025))
+	assert.Equal(t, "Lịch năm 2025", doc.Title)
+	assert.Equal(t, A4, doc.Size)
+	assert.Len(t, doc.Pages, 12)
+
+	january := doc.Pages[0]
+	assert.Equal(t, "Tháng 1 năm 2025", january.Title)
+	assert.Equal(t, "Năm Giáp Thìn - Ất Tỵ", january.Subtitle)
+	assert.Equal(t, "Năm Ất Tỵ", doc.Pages[5].Subtitle)
+	assert.Equal(t, "T2", january.Weekdays[0].Name)
+	assert.True(t, january.Weekdays[6].Sunday)
+	// 1 January 2025 is a Wednesday
+	assert.Len(t, january.Weeks, 5)
+	assert.True(t, january.Weeks[0][0].Empty)
+	assert.True(t, january.Weeks[0][1].Empty)
+	first := january.Weeks[0][2]
+	assert.Equal(t, 1, first.Solar)
+	assert.Equal(t, []string{"Tết Dương lịch"}, first.Holidays)
+	assert.Equal(t, "holiday", first.Class())
+	tet := january.Weeks[4][2]
+	assert.Equal(t, 29, tet.Solar)
+	assert.Equal(t, "1/1", tet.Lunar)
+	assert.Equal(t, "Mậu Tuất", tet.DayCanChi)
+	assert.Equal(t, "first-day holiday", tet.Class())
+	assert.True(t, january.Weeks[4][5].Empty)
+
+	assert.Equal(t, []Event{{Date: "05/01 09:30", Name: "Tiểu hàn"}, {Date: "20/01 02:52", Name: "Đại hàn"}}, january.SolarTerms)
+	assert.Equal(t, "Tiểu hàn", january.Weeks[0][6].SolarTerm)
+	assert.Equal(t, "sunday solar-term", january.Weeks[0][6].Class())
+	assert.Len(t, january.Holidays, 5)
+
+	// the grid fills the page between the title and the footer
+	assert.InDelta(t, 10+190, january.Weeks[0][6].X+january.CellWidth, 0.05)
+	assert.InDelta(t, january.FooterTop, january.Weeks[4][0].Y+january.CellHeight, 0.01)
+	assert.Less(t, january.FooterTop, A4.Height-10)
+}
+
+func TestPrinterSolarTermsCalendar(t *testing.T) {
+	// Đại hàn at 02:52 on 20 January in Vietnam is on the 19th in UTC
+	c := vncalendar.NewCalendar(vncalendar.WithLocation(time.UTC))
+	var dates []vncalendar.VNDate
+	for day := 1; day <= 31; day++ {
+		d, err := c.CheckedFromSolarTime(time.Date(2025, time.January, day, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		dates = append(dates, d)
+	}
+	page := NewPrinter().page("", "", dates)
+	assert.Equal(t, []Event{{Date: "05/01 02:30", Name: "Tiểu hàn"}, {Date: "19/01 19:52", Name: "Đại hàn"}}, page.SolarTerms)
+	assert.Equal(t, "Đại hàn", page.Weeks[2][6].SolarTerm)
+}
+
+func TestPrinterLunarYear(t *testing.T) {
+	doc, err := NewPrinter().LunarYear(2025)
+	require.NoError(t, err)
+	assert.Equal(t, "Lịch năm Ất Tỵ 2025", doc.Title)
+	// leap month 6
+	assert.Len(t, doc.Pages, 13)
+	assert.Equal(t, "Tháng 1 năm Ất Tỵ", doc.Pages[0].Title)
+	assert.Equal(t, "29/01/2025 - 27/02/2025", doc.Pages[0].Subtitle)
+	assert.Equal(t, "Tháng 6 nhuận năm Ất Tỵ", doc.Pages[6].Title)
+	assert.Equal(t, "Tháng 12 năm Ất Tỵ", doc.Pages[12].Title)
+	days := 0
+	for _, page := range doc.Pages {
+		for _, week := range page.Weeks {
+			for _, cell := range week {
+				if !cell.Empty {
+					days++
+				}
+			}
+		}
+	}
+	// 29 January 2025 to 16 February 2026
+	assert.Equal(t, 384, days)
+}
+
+func TestPrinterLunarYearBeforeReform(t *testing.T) {
+	// dates are proleptic Gregorian as time.Time, not the Julian dates of Lunar2solar
+	proleptic := vncalendar.NewCalendar(vncalendar.WithReform(vncalendar.ProlepticGregorian))
+	for _, year := range []int{1000, 1582} {
+		doc, err := NewPrinter().LunarYear(year)
+		require.NoError(t, err, year)
+		require.NotEmpty(t, doc.Pages, year)
+		tet, next := proleptic.Lunar2solar(year, 1, 1, false), proleptic.Lunar2solar(year+1, 1, 1, false)
+		first := doc.Pages[0].Subtitle[:10]
+		assert.Equal(t, fmt.Sprintf("%02d/%02d/%04d", tet.Day, tet.Month, tet.Year), first, year)
+		last := doc.Pages[len(doc.Pages)-1].Subtitle
+		end := time.Date(next.Year, time.Month(next.Month), next.Day-1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, end.Format("02/01/2006"), last[len(last)-10:], year)
+	}
+	doc, _ := NewPrinter().LunarYear(1000)
+	assert.Equal(t, "13/02/1000 - 14/03/1000", doc.Pages[0].Subtitle)
+
+	empty := NewPrinter().page("title", "subtitle", nil)
+	assert.Equal(t, "title", empty.Title)
+	assert.Empty(t, empty.Weeks)
+
+	var rangeErr *vncalendar.RangeError
+	_, err := NewPrinter().LunarYear(999)
+	assert.ErrorAs(t, err, &rangeErr)
+	_, err = NewPrinter().LunarYear(3000)
+	assert.ErrorAs(t, err, &rangeErr)
+	_, err = NewPrinter().LunarYear(3001)
+	assert.ErrorAs(t, err, &rangeErr)
+}
+
+func TestPrinterOptions(t *testing.T) {
+	p := NewPrinter(WithPageSize(A3.Landscape()), WithMargin(15), WithPrintWeekStart(time.Sunday))
+	page := p.Year(vncalendar.GetYearMonthDates(2025)).Pages[5]
+	assert.Equal(t, PageSize{Name: "A3 landscape", Width: 420, Height: 297}, page.Size)
+	assert.Equal(t, "CN", page.Weekdays[0].Name)
+	assert.Equal(t, 15.0, page.Weeks[0][0].X)
+	// 1 June 2025 is a Sunday
+	assert.Equal(t, 1, page.Weeks[0][0].Solar)
+	assert.InDelta(t, 390.0/7, page.CellWidth, 0.01)
+
+	page = NewPrinter(WithPrintWeekStart(9)).Year(vncalendar.GetYearMonthDates(2025)).Pages[5]
+	assert.Equal(t, "T3", page.Weekdays[0].Name)
+	// 1 June 2025 is a Sunday, the sixth cell from Tuesday
+	assert.Equal(t, 1, page.Weeks[0][5].Solar)
+}
+
+func TestPrinterHTML(t *testing.T) {
+	p := NewPrinter(WithPageSize(Letter))
+	var b bytes.Buffer
+	assert.NoError(t, p.HTML(&b, p.Year(vncalendar.GetYearMonthDates(2025))))
+	html := b.String()
+	assert.True(t, strings.HasPrefix(html, "<!DOCTYPE html>"))
+	assert.Contains(t, html, "@page { size: 215.9mm 279.4mm;")
+	assert.Equal(t, 12, strings.Count(html, `<section class="page">`))
+	assert.Contains(t, html, "<h1>Tháng 9 năm 2025</h1>")
+	assert.Contains(t, html, `<td class="full-moon holiday"><div class="solar">6</div><div class="lunar">15</div>`)
+	// self-contained
+	assert.NotContains(t, html, "<link")
+	assert.NotContains(t, html, "<script")
+}
+
+func TestPrinterSVG(t *testing.T) {
+	p := NewPrinter()
+	doc, err := p.LunarYear(2024)
+	require.NoError(t, err)
+	var b bytes.Buffer
+	assert.NoError(t, p.SVG(&b, doc.Pages[0]))
+	svg := b.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="210mm" height="297mm" viewBox="0 0 210 297"`))
+	assert.Contains(t, svg, "<title>Tháng 1 năm Giáp Thìn</title>")
+	assert.Contains(t, svg, ">Tết Nguyên Đán</text>")
+
+	// well formed XML
+	d := xml.NewDecoder(&b)
+	for {
+		_, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if err != nil {
+			break
+		}
+	}
+}
+
+func TestPrinterTemplates(t *testing.T) {
+	html := template.Must(template.New("titles").Funcs(Funcs).Parse(`{{range .Pages}}{{.Title}} {{add .Margin 1}};{{end}}`))
+	svg := template.Must(template.New("cells").Parse(`{{range .Weeks}}{{range .}}{{if not .Empty}}{{.Lunar}} {{end}}{{end}}{{end}}`))
+	p := NewPrinter(WithHTMLTemplate(html), WithSVGTemplate(svg))
+	doc, err := p.LunarYear(2025)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	assert.NoError(t, p.HTML(&b, doc))
+	assert.True(t, strings.HasPrefix(b.String(), "Tháng 1 năm Ất Tỵ 11;Tháng 2 năm Ất Tỵ 11;"))
+
+	b.Reset()
+	assert.NoError(t, p.SVG(&b, doc.Pages[6]))
+	assert.True(t, strings.HasPrefix(b.String(), "1/6n 2 3 "))
+
+	// not a number is an error of the template, not a panic
+	bad := template.Must(template.New("bad").Funcs(Funcs).Parse(`{{range .Pages}}{{mul .Title 2}}{{end}}`))
+	assert.ErrorContains(t, NewPrinter(WithHTMLTemplate(bad)).HTML(io.Discard, doc), "render: not a number")
+}
@@ -0,0 +1,155 @@
+// Package render draws month calendars from vncalendar.GetMonthDates
+// in the terminal and as printable HTML and SVG pages. DateFuncs brings
+// lunar dates to text/template and html/template
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+)
+
+// ANSI escape codes of the terminal renderer
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiDim    = "\x1b[2m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+)
+
+// cellWidth fits the first lunar day of a leap month, e.g. 1/4n
+const cellWidth = 5
+
+var weekdayShortNames = []string{"CN", "T2", "T3", "T4", "T5", "T6", "T7"}
+
+// Terminal renders a month as a wall calendar in the terminal,
+// each week has a line of solar days in bold and a line of lunar days
+// dimmed underneath. Mùng 1 shows the lunar month, rằm is yellow,
+// holidays and Sundays are red
+type Terminal struct {
+	weekStart time.Weekday
+	color     bool
+}
+
+type TerminalOption func(t *Terminal)
+
+// WithWeekStart sets the first day of the week, default is time.Monday.
+// Values outside Sunday to Saturday are taken modulo 7
+func WithWeekStart(day time.Weekday) TerminalOption {
+	return func(t *Terminal) {
+		t.weekStart = day
+	}
+}
+
+// WithoutColor renders plain text without ANSI escape codes
+func WithoutColor() TerminalOption {
+	return func(t *Terminal) {
+		t.color = false
+	}
+}
+
+// NewTerminal returns a Terminal with colors and weeks starting on Monday
+func NewTerminal(options ...TerminalOption) *Terminal {
+	t := &Terminal{weekStart: time.Monday, color: true}
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// Month renders the dates of a solar month as returned by GetMonthDates
+func (t *Terminal) Month(dates []vncalendar.VNDate) string {
+	if len(dates) == 0 {
+		return ""
+	}
+	first := dates[0].SolarTime()
+	var b strings.Builder
+	title := fmt.Sprintf("Tháng %d năm %d", first.Month(), first.Year())
+	b.WriteString(strings.Repeat(" ", max(0, (7*cellWidth-len([]rune(title)))/2)))
+	b.WriteString(t.paint(title, ansiBold))
+	b.WriteByte('\n')
+
+	var header []string
+	for _, day := range vncalendar.WeekDays(t.weekStart) {
+		style := ""
+		if day == time.Sunday {
+			style = ansiRed
+		}
+		header = append(header, t.cell(weekdayShortNames[day], style))
+	}
+	b.WriteString(strings.Join(header, ""))
+	b.WriteByte('\n')
+
+	for row, week := range vncalendar.MonthGrid(dates, t.weekStart) {
+		var solar, lunar []string
+		for _, d := range week {
+			if d == nil {
+				// no padding after the last day
+				if row > 0 {
+					break
+				}
+				solar = append(solar, strings.Repeat(" ", cellWidth))
+				lunar = append(lunar, strings.Repeat(" ", cellWidth))
+				continue
+			}
+			solar = append(solar, t.solarCell(*d))
+			lunar = append(lunar, t.lunarCell(*d))
+		}
+		b.WriteString(strings.Join(solar, ""))
+		b.WriteByte('\n')
+		b.WriteString(strings.Join(lunar, ""))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func (t *Terminal) solarCell(d vncalendar.VNDate) string {
+	style := ansiBold
+	if d.SolarTime().Weekday() == time.Sunday || len(d.Holidays()) > 0 {
+		style += ansiRed
+	}
+	return t.cell(strconv.Itoa(d.SolarTime().Day()), style)
+}
+
+func (t *Terminal) lunarCell(d vncalendar.VNDate) string {
+	style := ansiDim
+	switch {
+	case len(d.Holidays()) > 0:
+		style = ansiRed
+	case d.Day() == 1:
+		style = ansiCyan
+	case d.Day() == 15:
+		style = ansiYellow
+	}
+	return t.cell(LunarLabel(d.LunarDate()), style)
+}
+
+// cell right aligns s in the cell width, the padding is not styled
+func (t *Terminal) cell(s, style string) string {
+	return strings.Repeat(" ", max(0, cellWidth-len([]rune(s)))) + t.paint(s, style)
+}
+
+func (t *Terminal) paint(s, style string) string {
+	if !t.color || style == "" {
+		return s
+	}
+	return style + s + ansiReset
+}
+
+// LunarLabel is the lunar day, with the month on mùng 1
+// and n (nhuận) for a leap month, e.g. 1/4n
+func LunarLabel(d vncalendar.LunarDate) string {
+	if d.Day != 1 {
+		return strconv.Itoa(d.Day)
+	}
+	label := fmt.Sprintf("1/%d", d.Month)
+	if d.Leap {
+		label += "n"
+	}
+	return label
+}
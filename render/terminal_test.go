@@ -0,0 +1,75 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar"
+)
+
+func TestTerminalMonth(t *testing.T) {
+	r := NewTerminal(WithoutColor())
+	assert.Equal(t, `         Tháng 10 năm 2025
+   T2   T3   T4   T5   T6   T7   CN
+              1    2    3    4    5
+             10   11   12   13   14
+    6    7    8    9   10   11   12
+   15   16   17   18   19   20   21
+   13   14   15   16   17   18   19
+   22   23   24   25   26   27   28
+   20   21   22   23   24   25   26
+   29  1/9    2    3    4    5    6
+   27   28   29   30   31
+    7    8    9   10   11
+`, r.Month(vncalendar.GetMonthDates(2025, time.October)))
+	assert.Equal(t, "", r.Month(nil))
+}
+
+func TestTerminalWeekStart(t *testing.T) {
+	r := NewTerminal(WithoutColor(), WithWeekStart(time.Sunday))
+	lines := strings.Split(r.Month(vncalendar.GetMonthDates(2025, time.June)), "\n")
+	assert.Equal(t, "   CN   T2   T3   T4   T5   T6   T7", lines[1])
+	// 1 June 2025 is a Sunday, the first cell
+	assert.Equal(t, "    1    2    3    4    5    6    7", lines[2])
+	assert.Equal(t, "    6    7    8    9   10   11   12", lines[3])
+
+	r = NewTerminal(WithoutColor())
+	lines = strings.Split(r.Month(vncalendar.GetMonthDates(2025, time.June)), "\n")
+	assert.Equal(t, "                                  1", lines[2])
+
+	// taken modulo 7, 14 is a Sunday
+	assert.Equal(t, NewTerminal(WithoutColor(), WithWeekStart(time.Sunday)).Month(vncalendar.GetMonthDates(2025, time.June)),
+		NewTerminal(WithoutColor(), WithWeekStart(14)).Month(vncalendar.GetMonthDates(2025, time.June)))
+	lines = strings.Split(NewTerminal(WithoutColor(), WithWeekStart(-5)).Month(vncalendar.GetMonthDates(2025, time.June)), "\n")
+	assert.Equal(t, "   T3   T4   T5   T6   T7   CN   T2", lines[1])
+	assert.Equal(t, "                             1    2", lines[2])
+}
+
+func TestTerminalColor(t *testing.T) {
+	out := NewTerminal().Month(vncalendar.GetMonthDates(2025, time.October))
+	// Tết Trung Thu on 6 October, rằm in red as a holiday
+	assert.Contains(t, out, ansiBold+ansiRed+"6"+ansiReset)
+	assert.Contains(t, out, ansiRed+"15"+ansiReset)
+	// mùng 1 tháng 9
+	assert.Contains(t, out, ansiCyan+"1/9"+ansiReset)
+	assert.Contains(t, out, ansiDim+"16"+ansiReset)
+	assert.Contains(t, out, ansiRed+"CN"+ansiReset)
+
+	// rằm without holiday
+	out = NewTerminal().Month(vncalendar.GetMonthDates(2025, time.November))
+	assert.Contains(t, out, ansiYellow+"15"+ansiReset)
+	// without escape codes, the layout is the same
+	plain := NewTerminal(WithoutColor()).Month(vncalendar.GetMonthDates(2025, time.November))
+	for _, code := range []string{ansiBold, ansiDim, ansiRed, ansiYellow, ansiCyan, ansiReset} {
+		out = strings.ReplaceAll(out, code, "")
+	}
+	assert.Equal(t, plain, out)
+}
+
+func TestLunarLabel(t *testing.T) {
+	assert.Equal(t, "15", LunarLabel(vncalendar.LunarDate{Year: 2025, Month: 8, Day: 15}))
+	assert.Equal(t, "1/8", LunarLabel(vncalendar.LunarDate{Year: 2025, Month: 8, Day: 1}))
+	assert.Equal(t, "1/6n", LunarLabel(vncalendar.LunarDate{Year: 2025, Month: 6, Day: 1, Leap: true}))
+}
@@ -0,0 +1,24 @@
+module github.com/vanng822/vncalendar/rpc
+
+go 1.24.1
+
+require (
+	github.com/stretchr/testify v1.11.1
+	github.com/vanng822/vncalendar v0.0.0-20261019132518-9b1805560bf7
+	google.golang.org/grpc v1.80.0
+	google.golang.org/protobuf v1.36.11
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.49.0 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+	golang.org/x/text v0.33.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20260120221211-b8f7ae30c516 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// the calendar of this checkout for development and CI,
+// modules requiring rpc ignore the replace and use the version above
+replace github.com/vanng822/vncalendar => ../
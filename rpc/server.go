@@ -0,0 +1,164 @@
+// Package rpc serves the calendar over gRPC, the service is defined in
+// vncalendar/v1/calendar.proto. Register a Server with
+// vncalendarv1.RegisterCalendarServiceServer
+package rpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative vncalendar/v1/calendar.proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+	vncalendarv1 "github.com/vanng822/vncalendar/rpc/vncalendar/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxNextHolidays limits the count of NextHolidays
+const maxNextHolidays = 100
+
+// Server implements CalendarServiceServer
+type Server struct {
+	vncalendarv1.UnimplementedCalendarServiceServer
+}
+
+// NewServer returns the calendar service
+func NewServer() *Server {
+	return &Server{}
+}
+
+// Solar2Lunar converts a solar date
+func (s *Server) Solar2Lunar(ctx context.Context, req *vncalendarv1.SolarDate) (*vncalendarv1.VNDate, error) {
+	d, err := solarDate(req)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newVNDate(d), nil
+}
+
+// Lunar2Solar converts a lunar date
+func (s *Server) Lunar2Solar(ctx context.Context, req *vncalendarv1.LunarDate) (*vncalendarv1.VNDate, error) {
+	date, err := dateString(req.GetYear(), req.GetMonth(), req.GetDay())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	d, err := vncalendar.ParseLunarDate(date, req.GetLeap())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return newVNDate(vncalendar.FromSolarDay(d.SolarTime())), nil
+}
+
+// GetMonthDates lists the days of a solar month
+func (s *Server) GetMonthDates(ctx context.Context, req *vncalendarv1.GetMonthDatesRequest) (*vncalendarv1.GetMonthDatesResponse, error) {
+	if _, err := solarDate(&vncalendarv1.SolarDate{Year: req.GetYear(), Month: req.GetMonth(), Day: 1}); err != nil {
+		return nil, statusError(err)
+	}
+	resp := &vncalendarv1.GetMonthDatesResponse{}
+	for _, d := range vncalendar.GetMonthDates(int(req.GetYear()), time.Month(req.GetMonth())) {
+		resp.Dates = append(resp.Dates, newVNDate(d))
+	}
+	return resp, nil
+}
+
+// GetHolidays lists the holidays of a solar year
+func (s *Server) GetHolidays(ctx context.Context, req *vncalendarv1.GetHolidaysRequest) (*vncalendarv1.GetHolidaysResponse, error) {
+	if _, err := solarDate(&vncalendarv1.SolarDate{Year: req.GetYear(), Month: 1, Day: 1}); err != nil {
+		return nil, statusError(err)
+	}
+	resp := &vncalendarv1.GetHolidaysResponse{}
+	for _, hd := range vncalendar.HolidaysIn(int(req.GetYear())) {
+		resp.Holidays = append(resp.Holidays, newHoliday(hd))
+	}
+	return resp, nil
+}
+
+// NextHolidays lists the next holidays on or after a date
+func (s *Server) NextHolidays(ctx context.Context, req *vncalendarv1.NextHolidaysRequest) (*vncalendarv1.NextHolidaysResponse, error) {
+	from, err := solarDate(req.GetFrom())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	count := int(req.GetCount())
+	if count == 0 {
+		count = 1
+	}
+	if count < 0 || count > maxNextHolidays {
+		return nil, status.Errorf(codes.InvalidArgument, "count is 1-%d", maxNextHolidays)
+	}
+	resp := &vncalendarv1.NextHolidaysResponse{}
+	for year := from.SolarTime().Year(); year <= vncalendar.MaxSupportedYear; year++ {
+		for _, hd := range vncalendar.HolidaysIn(year) {
+			if hd.Date.JDN() < from.JDN() {
+				continue
+			}
+			resp.Holidays = append(resp.Holidays, newHoliday(hd))
+			if len(resp.Holidays) == count {
+				return resp, nil
+			}
+		}
+	}
+	return resp, nil
+}
+
+// solarDate parses d with ParseSolarDate
+func solarDate(d *vncalendarv1.SolarDate) (vncalendar.VNDate, error) {
+	date, err := dateString(d.GetYear(), d.GetMonth(), d.GetDay())
+	if err != nil {
+		return vncalendar.VNDate{}, err
+	}
+	return vncalendar.ParseSolarDate(date)
+}
+
+// dateString formats a date as YYYY-MM-DD for the parsers,
+// a year of more than four digits is out of range
+func dateString(year, month, day int32) (string, error) {
+	if year < 0 || year > 9999 {
+		return "", &vncalendar.RangeError{Year: int(year)}
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", year, month, day), nil
+}
+
+// statusError maps a *RangeError to OUT_OF_RANGE and invalid dates to INVALID_ARGUMENT
+func statusError(err error) error {
+	var rangeErr *vncalendar.RangeError
+	switch {
+	case errors.As(err, &rangeErr):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, vncalendar.ErrInvalidDateFormat),
+		errors.Is(err, vncalendar.ErrInvalidMonth),
+		errors.Is(err, vncalendar.ErrInvalidDay),
+		errors.Is(err, vncalendar.ErrInvalidDate):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func newVNDate(d vncalendar.VNDate) *vncalendarv1.VNDate {
+	solar := d.SolarTime().In(vncalendar.VietNamTimeZone)
+	lunar := d.LunarDate()
+	date := &vncalendarv1.VNDate{
+		Solar:       &vncalendarv1.SolarDate{Year: int32(solar.Year()), Month: int32(solar.Month()), Day: int32(solar.Day())},
+		Lunar:       &vncalendarv1.LunarDate{Year: int32(lunar.Year), Month: int32(lunar.Month), Day: int32(lunar.Day), Leap: lunar.Leap},
+		YearCanChi:  d.YearCanChi().String(),
+		MonthCanChi: d.MonthCanChi().String(),
+		DayCanChi:   d.DayCanChi().String(),
+		SolarTerm:   d.SolarTerm().String(),
+	}
+	for _, h := range d.Holidays() {
+		date.Holidays = append(date.Holidays, h.Name)
+	}
+	return date
+}
+
+func newHoliday(hd vncalendar.HolidayDate) *vncalendarv1.Holiday {
+	return &vncalendarv1.Holiday{
+		Name:   hd.Name,
+		Lunar:  hd.Lunar,
+		Public: hd.Public,
+		Date:   newVNDate(vncalendar.FromSolarDay(hd.Date.SolarTime())),
+	}
+}
@@ -0,0 +1,154 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	vncalendarv1 "github.com/vanng822/vncalendar/rpc/vncalendar/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newClient serves the calendar on an in-process listener
+func newClient(t *testing.T) vncalendarv1.CalendarServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	vncalendarv1.RegisterCalendarServiceServer(s, NewServer())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return vncalendarv1.NewCalendarServiceClient(conn)
+}
+
+func TestSolar2Lunar(t *testing.T) {
+	client := newClient(t)
+	d, err := client.Solar2Lunar(context.Background(), &vncalendarv1.SolarDate{Year: 2025, Month: 1, Day: 29})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2025), d.Lunar.Year)
+	assert.Equal(t, int32(1), d.Lunar.Month)
+	assert.Equal(t, int32(1), d.Lunar.Day)
+	assert.False(t, d.Lunar.Leap)
+	assert.Equal(t, "Ất Tỵ", d.YearCanChi)
+	assert.Equal(t, "Mậu Tuất", d.DayCanChi)
+	assert.Contains(t, d.Holidays, "Tết Nguyên Đán")
+}
+
+func TestLunar2Solar(t *testing.T) {
+	client := newClient(t)
+	d, err := client.Lunar2Solar(context.Background(), &vncalendarv1.LunarDate{Year: 2025, Month: 6, Day: 15, Leap: true})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2025, 8, 8}, []int32{d.Solar.Year, d.Solar.Month, d.Solar.Day})
+	assert.True(t, d.Lunar.Leap)
+
+	d, err = client.Lunar2Solar(context.Background(), &vncalendarv1.LunarDate{Year: 2025, Month: 6, Day: 15})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2025, 7, 9}, []int32{d.Solar.Year, d.Solar.Month, d.Solar.Day})
+}
+
+func TestGetMonthDates(t *testing.T) {
+	resp, err := newClient(t).GetMonthDates(context.Background(), &vncalendarv1.GetMonthDatesRequest{Year: 2025, Month: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Dates, 28)
+	assert.Equal(t, int32(1), resp.Dates[0].Solar.Day)
+	assert.Equal(t, int32(4), resp.Dates[0].Lunar.Day)
+	assert.Equal(t, int32(1), resp.Dates[27].Lunar.Day)
+	assert.Equal(t, int32(2), resp.Dates[27].Lunar.Month)
+}
+
+func TestHolidays(t *testing.T) {
+	client := newClient(t)
+	resp, err := client.GetHolidays(context.Background(), &vncalendarv1.GetHolidaysRequest{Year: 2025})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Holidays)
+	assert.Equal(t, "Tết Dương lịch", resp.Holidays[0].Name)
+	assert.True(t, resp.Holidays[0].Public)
+
+	next, err := client.NextHolidays(context.Background(), &vncalendarv1.NextHolidaysRequest{From: &vncalendarv1.SolarDate{Year: 2025, Month: 12, Day: 31}, Count: 2})
+	require.NoError(t, err)
+	require.Len(t, next.Holidays, 2)
+	assert.Equal(t, "Tết Dương lịch", next.Holidays[0].Name)
+	assert.Equal(t, int32(2026), next.Holidays[0].Date.Solar.Year)
+
+	next, err = client.NextHolidays(context.Background(), &vncalendarv1.NextHolidaysRequest{From: &vncalendarv1.SolarDate{Year: 2025, Month: 1, Day: 29}})
+	require.NoError(t, err)
+	require.Len(t, next.Holidays, 1)
+	assert.Equal(t, int32(29), next.Holidays[0].Date.Solar.Day)
+}
+
+func TestErrors(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	for name, tc := range map[string]struct {
+		call func() error
+		code codes.Code
+	}{
+		"solar month": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 2025, Month: 13, Day: 1})
+			return err
+		}, codes.InvalidArgument},
+		"solar day": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 2025, Month: 2, Day: 29})
+			return err
+		}, codes.InvalidArgument},
+		"solar year": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 999, Month: 1, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		"solar format": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 2025, Month: 100, Day: 1})
+			return err
+		}, codes.InvalidArgument},
+		"solar five digits": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 10000, Month: 1, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		"solar after range": {func() error {
+			_, err := client.Solar2Lunar(ctx, &vncalendarv1.SolarDate{Year: 3001, Month: 1, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		"lunar leap": {func() error {
+			_, err := client.Lunar2Solar(ctx, &vncalendarv1.LunarDate{Year: 2024, Month: 4, Day: 1, Leap: true})
+			return err
+		}, codes.InvalidArgument},
+		"lunar year": {func() error {
+			_, err := client.Lunar2Solar(ctx, &vncalendarv1.LunarDate{Year: 3001, Month: 1, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		// the solar date is in 3001
+		"lunar end of range": {func() error {
+			_, err := client.Lunar2Solar(ctx, &vncalendarv1.LunarDate{Year: 3000, Month: 12, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		"lunar negative year": {func() error {
+			_, err := client.Lunar2Solar(ctx, &vncalendarv1.LunarDate{Year: -1, Month: 1, Day: 1})
+			return err
+		}, codes.OutOfRange},
+		"month": {func() error {
+			_, err := client.GetMonthDates(ctx, &vncalendarv1.GetMonthDatesRequest{Year: 2025})
+			return err
+		}, codes.InvalidArgument},
+		"holidays": {func() error { _, err := client.GetHolidays(ctx, &vncalendarv1.GetHolidaysRequest{}); return err }, codes.OutOfRange},
+		"count": {func() error {
+			_, err := client.NextHolidays(ctx, &vncalendarv1.NextHolidaysRequest{From: &vncalendarv1.SolarDate{Year: 2025, Month: 1, Day: 1}, Count: -1})
+			return err
+		}, codes.InvalidArgument},
+	} {
+		err := tc.call()
+		require.Error(t, err, name)
+		assert.Equal(t, tc.code, status.Code(err), name)
+	}
+}
@@ -0,0 +1,722 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: vncalendar/v1/calendar.proto
+
+package vncalendarv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A proleptic Gregorian date
+type SolarDate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolarDate) Reset() {
+	*x = SolarDate{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarDate) ProtoMessage() {}
+
+func (x *SolarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarDate.ProtoReflect.Descriptor instead.
+func (*SolarDate) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SolarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SolarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *SolarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// A lunar date, leap is true in the leap month of the year
+type LunarDate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Leap          bool                   `protobuf:"varint,4,opt,name=leap,proto3" json:"leap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LunarDate) Reset() {
+	*x = LunarDate{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LunarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LunarDate) ProtoMessage() {}
+
+func (x *LunarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LunarDate.ProtoReflect.Descriptor instead.
+func (*LunarDate) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LunarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LunarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *LunarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *LunarDate) GetLeap() bool {
+	if x != nil {
+		return x.Leap
+	}
+	return false
+}
+
+// A day with its solar and lunar date
+type VNDate struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Solar       *SolarDate             `protobuf:"bytes,1,opt,name=solar,proto3" json:"solar,omitempty"`
+	Lunar       *LunarDate             `protobuf:"bytes,2,opt,name=lunar,proto3" json:"lunar,omitempty"`
+	YearCanChi  string                 `protobuf:"bytes,3,opt,name=year_can_chi,json=yearCanChi,proto3" json:"year_can_chi,omitempty"`
+	MonthCanChi string                 `protobuf:"bytes,4,opt,name=month_can_chi,json=monthCanChi,proto3" json:"month_can_chi,omitempty"`
+	DayCanChi   string                 `protobuf:"bytes,5,opt,name=day_can_chi,json=dayCanChi,proto3" json:"day_can_chi,omitempty"`
+	SolarTerm   string                 `protobuf:"bytes,6,opt,name=solar_term,json=solarTerm,proto3" json:"solar_term,omitempty"`
+	// Names of the holidays on the day
+	Holidays      []string `protobuf:"bytes,7,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VNDate) Reset() {
+	*x = VNDate{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VNDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VNDate) ProtoMessage() {}
+
+func (x *VNDate) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VNDate.ProtoReflect.Descriptor instead.
+func (*VNDate) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VNDate) GetSolar() *SolarDate {
+	if x != nil {
+		return x.Solar
+	}
+	return nil
+}
+
+func (x *VNDate) GetLunar() *LunarDate {
+	if x != nil {
+		return x.Lunar
+	}
+	return nil
+}
+
+func (x *VNDate) GetYearCanChi() string {
+	if x != nil {
+		return x.YearCanChi
+	}
+	return ""
+}
+
+func (x *VNDate) GetMonthCanChi() string {
+	if x != nil {
+		return x.MonthCanChi
+	}
+	return ""
+}
+
+func (x *VNDate) GetDayCanChi() string {
+	if x != nil {
+		return x.DayCanChi
+	}
+	return ""
+}
+
+func (x *VNDate) GetSolarTerm() string {
+	if x != nil {
+		return x.SolarTerm
+	}
+	return ""
+}
+
+func (x *VNDate) GetHolidays() []string {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type Holiday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The holiday follows the lunar calendar
+	Lunar bool `protobuf:"varint,2,opt,name=lunar,proto3" json:"lunar,omitempty"`
+	// Public holiday with a day off
+	Public        bool    `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"`
+	Date          *VNDate `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Holiday) GetLunar() bool {
+	if x != nil {
+		return x.Lunar
+	}
+	return false
+}
+
+func (x *Holiday) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Holiday) GetDate() *VNDate {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetMonthDatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthDatesRequest) Reset() {
+	*x = GetMonthDatesRequest{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthDatesRequest) ProtoMessage() {}
+
+func (x *GetMonthDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthDatesRequest.ProtoReflect.Descriptor instead.
+func (*GetMonthDatesRequest) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetMonthDatesRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetMonthDatesRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type GetMonthDatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dates         []*VNDate              `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthDatesResponse) Reset() {
+	*x = GetMonthDatesResponse{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthDatesResponse) ProtoMessage() {}
+
+func (x *GetMonthDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthDatesResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthDatesResponse) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMonthDatesResponse) GetDates() []*VNDate {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+type GetHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Solar year
+	Year          int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysRequest) Reset() {
+	*x = GetHolidaysRequest{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysRequest) ProtoMessage() {}
+
+func (x *GetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetHolidaysRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHolidaysResponse) Reset() {
+	*x = GetHolidaysResponse{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysResponse) ProtoMessage() {}
+
+func (x *GetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHolidaysResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type NextHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *SolarDate             `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Number of holidays, 1 when not set
+	Count         int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextHolidaysRequest) Reset() {
+	*x = NextHolidaysRequest{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextHolidaysRequest) ProtoMessage() {}
+
+func (x *NextHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*NextHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NextHolidaysRequest) GetFrom() *SolarDate {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *NextHolidaysRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NextHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextHolidaysResponse) Reset() {
+	*x = NextHolidaysResponse{}
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextHolidaysResponse) ProtoMessage() {}
+
+func (x *NextHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vncalendar_v1_calendar_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*NextHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_vncalendar_v1_calendar_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NextHolidaysResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+var File_vncalendar_v1_calendar_proto protoreflect.FileDescriptor
+
+const file_vncalendar_v1_calendar_proto_rawDesc = "" +
+	"\n" +
+	"\x1cvncalendar/v1/calendar.proto\x12\rvncalendar.v1\"G\n" +
+	"\tSolarDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\"[\n" +
+	"\tLunarDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x12\n" +
+	"\x04leap\x18\x04 \x01(\bR\x04leap\"\x89\x02\n" +
+	"\x06VNDate\x12.\n" +
+	"\x05solar\x18\x01 \x01(\v2\x18.vncalendar.v1.SolarDateR\x05solar\x12.\n" +
+	"\x05lunar\x18\x02 \x01(\v2\x18.vncalendar.v1.LunarDateR\x05lunar\x12 \n" +
+	"\fyear_can_chi\x18\x03 \x01(\tR\n" +
+	"yearCanChi\x12\"\n" +
+	"\rmonth_can_chi\x18\x04 \x01(\tR\vmonthCanChi\x12\x1e\n" +
+	"\vday_can_chi\x18\x05 \x01(\tR\tdayCanChi\x12\x1d\n" +
+	"\n" +
+	"solar_term\x18\x06 \x01(\tR\tsolarTerm\x12\x1a\n" +
+	"\bholidays\x18\a \x03(\tR\bholidays\"v\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05lunar\x18\x02 \x01(\bR\x05lunar\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\x12)\n" +
+	"\x04date\x18\x04 \x01(\v2\x15.vncalendar.v1.VNDateR\x04date\"@\n" +
+	"\x14GetMonthDatesRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\"D\n" +
+	"\x15GetMonthDatesResponse\x12+\n" +
+	"\x05dates\x18\x01 \x03(\v2\x15.vncalendar.v1.VNDateR\x05dates\"(\n" +
+	"\x12GetHolidaysRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"I\n" +
+	"\x13GetHolidaysResponse\x122\n" +
+	"\bholidays\x18\x01 \x03(\v2\x16.vncalendar.v1.HolidayR\bholidays\"Y\n" +
+	"\x13NextHolidaysRequest\x12,\n" +
+	"\x04from\x18\x01 \x01(\v2\x18.vncalendar.v1.SolarDateR\x04from\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"J\n" +
+	"\x14NextHolidaysResponse\x122\n" +
+	"\bholidays\x18\x01 \x03(\v2\x16.vncalendar.v1.HolidayR\bholidays2\x9c\x03\n" +
+	"\x0fCalendarService\x12>\n" +
+	"\vSolar2Lunar\x12\x18.vncalendar.v1.SolarDate\x1a\x15.vncalendar.v1.VNDate\x12>\n" +
+	"\vLunar2Solar\x12\x18.vncalendar.v1.LunarDate\x1a\x15.vncalendar.v1.VNDate\x12Z\n" +
+	"\rGetMonthDates\x12#.vncalendar.v1.GetMonthDatesRequest\x1a$.vncalendar.v1.GetMonthDatesResponse\x12T\n" +
+	"\vGetHolidays\x12!.vncalendar.v1.GetHolidaysRequest\x1a\".vncalendar.v1.GetHolidaysResponse\x12W\n" +
+	"\fNextHolidays\x12\".vncalendar.v1.NextHolidaysRequest\x1a#.vncalendar.v1.NextHolidaysResponseB?Z=github.com/vanng822/vncalendar/rpc/vncalendar/v1;vncalendarv1b\x06proto3"
+
+var (
+	file_vncalendar_v1_calendar_proto_rawDescOnce sync.Once
+	file_vncalendar_v1_calendar_proto_rawDescData []byte
+)
+
+func file_vncalendar_v1_calendar_proto_rawDescGZIP() []byte {
+	file_vncalendar_v1_calendar_proto_rawDescOnce.Do(func() {
+		file_vncalendar_v1_calendar_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vncalendar_v1_calendar_proto_rawDesc), len(file_vncalendar_v1_calendar_proto_rawDesc)))
+	})
+	return file_vncalendar_v1_calendar_proto_rawDescData
+}
+
+var file_vncalendar_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_vncalendar_v1_calendar_proto_goTypes = []any{
+	(*SolarDate)(nil),             // 0: vncalendar.v1.SolarDate
+	(*LunarDate)(nil),             // 1: vncalendar.v1.LunarDate
+	(*VNDate)(nil),                // 2: vncalendar.v1.VNDate
+	(*Holiday)(nil),               // 3: vncalendar.v1.Holiday
+	(*GetMonthDatesRequest)(nil),  // 4: vncalendar.v1.GetMonthDatesRequest
+	(*GetMonthDatesResponse)(nil), // 5: vncalendar.v1.GetMonthDatesResponse
+	(*GetHolidaysRequest)(nil),    // 6: vncalendar.v1.GetHolidaysRequest
+	(*GetHolidaysResponse)(nil),   // 7: vncalendar.v1.GetHolidaysResponse
+	(*NextHolidaysRequest)(nil),   // 8: vncalendar.v1.NextHolidaysRequest
+	(*NextHolidaysResponse)(nil),  // 9: vncalendar.v1.NextHolidaysResponse
+}
+var file_vncalendar_v1_calendar_proto_depIdxs = []int32{
+	0,  // 0: vncalendar.v1.VNDate.solar:type_name -> vncalendar.v1.SolarDate
+	1,  // 1: vncalendar.v1.VNDate.lunar:type_name -> vncalendar.v1.LunarDate
+	2,  // 2: vncalendar.v1.Holiday.date:type_name -> vncalendar.v1.VNDate
+	2,  // 3: vncalendar.v1.GetMonthDatesResponse.dates:type_name -> vncalendar.v1.VNDate
+	3,  // 4: vncalendar.v1.GetHolidaysResponse.holidays:type_name -> vncalendar.v1.Holiday
+	0,  // 5: vncalendar.v1.NextHolidaysRequest.from:type_name -> vncalendar.v1.SolarDate
+	3,  // 6: vncalendar.v1.NextHolidaysResponse.holidays:type_name -> vncalendar.v1.Holiday
+	0,  // 7: vncalendar.v1.CalendarService.Solar2Lunar:input_type -> vncalendar.v1.SolarDate
+	1,  // 8: vncalendar.v1.CalendarService.Lunar2Solar:input_type -> vncalendar.v1.LunarDate
+	4,  // 9: vncalendar.v1.CalendarService.GetMonthDates:input_type -> vncalendar.v1.GetMonthDatesRequest
+	6,  // 10: vncalendar.v1.CalendarService.GetHolidays:input_type -> vncalendar.v1.GetHolidaysRequest
+	8,  // 11: vncalendar.v1.CalendarService.NextHolidays:input_type -> vncalendar.v1.NextHolidaysRequest
+	2,  // 12: vncalendar.v1.CalendarService.Solar2Lunar:output_type -> vncalendar.v1.VNDate
+	2,  // 13: vncalendar.v1.CalendarService.Lunar2Solar:output_type -> vncalendar.v1.VNDate
+	5,  // 14: vncalendar.v1.CalendarService.GetMonthDates:output_type -> vncalendar.v1.GetMonthDatesResponse
+	7,  // 15: vncalendar.v1.CalendarService.GetHolidays:output_type -> vncalendar.v1.GetHolidaysResponse
+	9,  // 16: vncalendar.v1.CalendarService.NextHolidays:output_type -> vncalendar.v1.NextHolidaysResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_vncalendar_v1_calendar_proto_init() }
+func file_vncalendar_v1_calendar_proto_init() {
+	if File_vncalendar_v1_calendar_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vncalendar_v1_calendar_proto_rawDesc), len(file_vncalendar_v1_calendar_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_vncalendar_v1_calendar_proto_goTypes,
+		DependencyIndexes: file_vncalendar_v1_calendar_proto_depIdxs,
+		MessageInfos:      file_vncalendar_v1_calendar_proto_msgTypes,
+	}.Build()
+	File_vncalendar_v1_calendar_proto = out.File
+	file_vncalendar_v1_calendar_proto_goTypes = nil
+	file_vncalendar_v1_calendar_proto_depIdxs = nil
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: vncalendar/v1/calendar.proto
+
+package vncalendarv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CalendarService_Solar2Lunar_FullMethodName   = "/vncalendar.v1.CalendarService/Solar2Lunar"
+	CalendarService_Lunar2Solar_FullMethodName   = "/vncalendar.v1.CalendarService/Lunar2Solar"
+	CalendarService_GetMonthDates_FullMethodName = "/vncalendar.v1.CalendarService/GetMonthDates"
+	CalendarService_GetHolidays_FullMethodName   = "/vncalendar.v1.CalendarService/GetHolidays"
+	CalendarService_NextHolidays_FullMethodName  = "/vncalendar.v1.CalendarService/NextHolidays"
+)
+
+// CalendarServiceClient is the client API for CalendarService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Vietnamese lunar calendar conversions, dates are in Vietnam time (UTC+7).
+// Invalid dates fail with INVALID_ARGUMENT, years outside
+// 1000-3000 with OUT_OF_RANGE
+type CalendarServiceClient interface {
+	// Converts a solar date
+	Solar2Lunar(ctx context.Context, in *SolarDate, opts ...grpc.CallOption) (*VNDate, error)
+	// Converts a lunar date
+	Lunar2Solar(ctx context.Context, in *LunarDate, opts ...grpc.CallOption) (*VNDate, error)
+	// Lists the days of a solar month
+	GetMonthDates(ctx context.Context, in *GetMonthDatesRequest, opts ...grpc.CallOption) (*GetMonthDatesResponse, error)
+	// Lists the holidays of a solar year
+	GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error)
+	// Lists the next holidays on or after a date
+	NextHolidays(ctx context.Context, in *NextHolidaysRequest, opts ...grpc.CallOption) (*NextHolidaysResponse, error)
+}
+
+type calendarServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarServiceClient(cc grpc.ClientConnInterface) CalendarServiceClient {
+	return &calendarServiceClient{cc}
+}
+
+func (c *calendarServiceClient) Solar2Lunar(ctx context.Context, in *SolarDate, opts ...grpc.CallOption) (*VNDate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNDate)
+	err := c.cc.Invoke(ctx, CalendarService_Solar2Lunar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) Lunar2Solar(ctx context.Context, in *LunarDate, opts ...grpc.CallOption) (*VNDate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VNDate)
+	err := c.cc.Invoke(ctx, CalendarService_Lunar2Solar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) GetMonthDates(ctx context.Context, in *GetMonthDatesRequest, opts ...grpc.CallOption) (*GetMonthDatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMonthDatesResponse)
+	err := c.cc.Invoke(ctx, CalendarService_GetMonthDates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolidaysResponse)
+	err := c.cc.Invoke(ctx, CalendarService_GetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarServiceClient) NextHolidays(ctx context.Context, in *NextHolidaysRequest, opts ...grpc.CallOption) (*NextHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextHolidaysResponse)
+	err := c.cc.Invoke(ctx, CalendarService_NextHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarServiceServer is the server API for CalendarService service.
+// All implementations must embed UnimplementedCalendarServiceServer
+// for forward compatibility.
+//
+// Vietnamese lunar calendar conversions, dates are in Vietnam time (UTC+7).
+// Invalid dates fail with INVALID_ARGUMENT, years outside
+// 1000-3000 with OUT_OF_RANGE
+type CalendarServiceServer interface {
+	// Converts a solar date
+	Solar2Lunar(context.Context, *SolarDate) (*VNDate, error)
+	// Converts a lunar date
+	Lunar2Solar(context.Context, *LunarDate) (*VNDate, error)
+	// Lists the days of a solar month
+	GetMonthDates(context.Context, *GetMonthDatesRequest) (*GetMonthDatesResponse, error)
+	// Lists the holidays of a solar year
+	GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error)
+	// Lists the next holidays on or after a date
+	NextHolidays(context.Context, *NextHolidaysRequest) (*NextHolidaysResponse, error)
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+// UnimplementedCalendarServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCalendarServiceServer struct{}
+
+func (UnimplementedCalendarServiceServer) Solar2Lunar(context.Context, *SolarDate) (*VNDate, error) {
+	return nil, status.Error(codes.Unimplemented, "method Solar2Lunar not implemented")
+}
+func (UnimplementedCalendarServiceServer) Lunar2Solar(context.Context, *LunarDate) (*VNDate, error) {
+	return nil, status.Error(codes.Unimplemented, "method Lunar2Solar not implemented")
+}
+func (UnimplementedCalendarServiceServer) GetMonthDates(context.Context, *GetMonthDatesRequest) (*GetMonthDatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMonthDates not implemented")
+}
+func (UnimplementedCalendarServiceServer) GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHolidays not implemented")
+}
+func (UnimplementedCalendarServiceServer) NextHolidays(context.Context, *NextHolidaysRequest) (*NextHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method NextHolidays not implemented")
+}
+func (UnimplementedCalendarServiceServer) mustEmbedUnimplementedCalendarServiceServer() {}
+func (UnimplementedCalendarServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCalendarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarServiceServer will
+// result in compilation errors.
+type UnsafeCalendarServiceServer interface {
+	mustEmbedUnimplementedCalendarServiceServer()
+}
+
+func RegisterCalendarServiceServer(s grpc.ServiceRegistrar, srv CalendarServiceServer) {
+	// If the following call panics, it indicates UnimplementedCalendarServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CalendarService_ServiceDesc, srv)
+}
+
+func _CalendarService_Solar2Lunar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolarDate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).Solar2Lunar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_Solar2Lunar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).Solar2Lunar(ctx, req.(*SolarDate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_Lunar2Solar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LunarDate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).Lunar2Solar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_Lunar2Solar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).Lunar2Solar(ctx, req.(*LunarDate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_GetMonthDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonthDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).GetMonthDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_GetMonthDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).GetMonthDates(ctx, req.(*GetMonthDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).GetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_GetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).GetHolidays(ctx, req.(*GetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarService_NextHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarServiceServer).NextHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarService_NextHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarServiceServer).NextHolidays(ctx, req.(*NextHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarService_ServiceDesc is the grpc.ServiceDesc for CalendarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vncalendar.v1.CalendarService",
+	HandlerType: (*CalendarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Solar2Lunar",
+			Handler:    _CalendarService_Solar2Lunar_Handler,
+		},
+		{
+			MethodName: "Lunar2Solar",
+			Handler:    _CalendarService_Lunar2Solar_Handler,
+		},
+		{
+			MethodName: "GetMonthDates",
+			Handler:    _CalendarService_GetMonthDates_Handler,
+		},
+		{
+			MethodName: "GetHolidays",
+			Handler:    _CalendarService_GetHolidays_Handler,
+		},
+		{
+			MethodName: "NextHolidays",
+			Handler:    _CalendarService_NextHolidays_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vncalendar/v1/calendar.proto",
+}
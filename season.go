@@ -0,0 +1,122 @@
+package vncalendar
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// Season is one of the four seasons (mùa)
+type Season int
+
+const (
+	Spring Season = iota // Xuân
+	Summer               // Hạ
+	Autumn               // Thu
+	Winter               // Đông
+)
+
+var seasonNames = []string{"Xuân", "Hạ", "Thu", "Đông"}
+
+func (s Season) String() string {
+	return seasonNames[mod(int(s), 4)]
+}
+
+// SeasonDefinition decides the solar terms starting the seasons
+type SeasonDefinition int
+
+const (
+	// AstronomicalSeasons start at the equinoxes and solstices,
+	// spring at Xuân phân
+	AstronomicalSeasons SeasonDefinition = iota
+	// TraditionalSeasons start at the four Lập terms,
+	// spring at Lập xuân, about 45 days before the equinox
+	TraditionalSeasons
+)
+
+// Start returns the solar term starting the season
+func (s Season) Start(definition SeasonDefinition) SolarTerm {
+	term := SolarTerm(mod(int(s), 4) * 6)
+	if definition == TraditionalSeasons {
+		term = SolarTerm(mod(int(term)-3, 24))
+	}
+	return term
+}
+
+// SeasonPeriod is a season from its start to the start of the next one
+type SeasonPeriod struct {
+	Season     Season
+	Start, End time.Time
+}
+
+// Length returns the duration of the season
+func (p SeasonPeriod) Length() time.Duration {
+	return p.End.Sub(p.Start)
+}
+
+// Contains reports whether t is in the season
+func (p SeasonPeriod) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// MarchEquinox returns the exact time of Xuân phân in Vietnam time zone
+func MarchEquinox(year int) time.Time {
+	return solarTermTime(defaultAstronomy(), year, XuanPhan, VietNamTimeZone)
+}
+
+// JuneSolstice returns the exact time of Hạ chí in Vietnam time zone
+func JuneSolstice(year int) time.Time {
+	return solarTermTime(defaultAstronomy(), year, HaChi, VietNamTimeZone)
+}
+
+// SeptemberEquinox returns the exact time of Thu phân in Vietnam time zone
+func SeptemberEquinox(year int) time.Time {
+	return solarTermTime(defaultAstronomy(), year, ThuPhan, VietNamTimeZone)
+}
+
+// DecemberSolstice returns the exact time of Đông chí in Vietnam time zone
+func DecemberSolstice(year int) time.Time {
+	return solarTermTime(defaultAstronomy(), year, DongChi, VietNamTimeZone)
+}
+
+// Seasons returns the four seasons starting in the given solar year,
+// winter ends in the next year
+func Seasons(year int, definition SeasonDefinition) []SeasonPeriod {
+	return seasons(defaultAstronomy(), year, definition, VietNamTimeZone)
+}
+
+// Seasons works as the package level Seasons using the calendar settings
+func (c *Calendar) Seasons(year int, definition SeasonDefinition) []SeasonPeriod {
+	return seasons(c.astronomy(), year, definition, c.location)
+}
+
+func seasons(a astronomy, year int, definition SeasonDefinition, loc *time.Location) []SeasonPeriod {
+	periods := make([]SeasonPeriod, 0, 4)
+	for s := Spring; s <= Winter; s++ {
+		period := SeasonPeriod{
+			Season: s,
+			Start:  solarTermTime(a, year, s.Start(definition), loc),
+		}
+		if s == Winter {
+			period.End = solarTermTime(a, year+1, Spring.Start(definition), loc)
+		} else {
+			period.End = solarTermTime(a, year, (s + 1).Start(definition), loc)
+		}
+		periods = append(periods, period)
+	}
+	return periods
+}
+
+func solarTermTime(a astronomy, year int, term SolarTerm, loc *time.Location) time.Time {
+	return astro.TimeFromJD(solarTermJD(a, year, term.Longitude())).In(loc)
+}
+
+// Season returns the season the date's time is in
+func (t VNDate) Season(definition SeasonDefinition) Season {
+	longitude := t.astronomy().apparentSunLongitude(astro.JDFromTime(t.solarTime))
+	if definition == TraditionalSeasons {
+		longitude -= LapXuan.Longitude()
+	}
+	return Season(int(math.Mod(longitude+360, 360) / 90))
+}
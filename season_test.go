@@ -0,0 +1,66 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEquinoxesAndSolstices(t *testing.T) {
+	assert.WithinDuration(t, time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC), MarchEquinox(2024), 10*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC), JuneSolstice(2024), 10*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC), SeptemberEquinox(2024), 10*time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.December, 21, 9, 21, 0, 0, time.UTC), DecemberSolstice(2024), 10*time.Minute)
+	assert.Equal(t, VietNamTimeZone, MarchEquinox(2024).Location())
+}
+
+func TestSeasons(t *testing.T) {
+	seasons := Seasons(2024, AstronomicalSeasons)
+	assert.Len(t, seasons, 4)
+	assert.Equal(t, MarchEquinox(2024), seasons[0].Start)
+	assert.Equal(t, JuneSolstice(2024), seasons[0].End)
+	assert.Equal(t, MarchEquinox(2025), seasons[3].End)
+	// the sun is slowest in July, summer is the longest season
+	assert.InDelta(t, 92.7, seasons[0].Length().Hours()/24, 0.1)
+	assert.InDelta(t, 93.7, seasons[1].Length().Hours()/24, 0.1)
+	assert.InDelta(t, 89.8, seasons[2].Length().Hours()/24, 0.1)
+	assert.InDelta(t, 89.0, seasons[3].Length().Hours()/24, 0.1)
+	for i, s := range seasons {
+		assert.Equal(t, Season(i), s.Season)
+		if i > 0 {
+			assert.Equal(t, seasons[i-1].End, s.Start)
+		}
+	}
+
+	traditional := Seasons(2024, TraditionalSeasons)
+	assert.Equal(t, LapXuan, Spring.Start(TraditionalSeasons))
+	assert.Equal(t, LapDong, Winter.Start(TraditionalSeasons))
+	assert.Equal(t, ThuPhan, Autumn.Start(AstronomicalSeasons))
+	assert.WithinDuration(t, time.Date(2024, time.February, 4, 8, 27, 0, 0, time.UTC), traditional[0].Start, 10*time.Minute)
+	assert.Equal(t, "Xuân", traditional[0].Season.String())
+	assert.True(t, traditional[3].Contains(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, traditional[3].Contains(traditional[3].End))
+}
+
+func TestVNDateSeason(t *testing.T) {
+	d := FromSolarTime(time.Date(2024, time.March, 1, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, Winter, d.Season(AstronomicalSeasons))
+	assert.Equal(t, Spring, d.Season(TraditionalSeasons))
+
+	d = FromSolarTime(time.Date(2024, time.August, 1, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, Summer, d.Season(AstronomicalSeasons))
+	assert.Equal(t, Summer, d.Season(TraditionalSeasons))
+
+	d = FromSolarTime(time.Date(2024, time.August, 10, 12, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, Summer, d.Season(AstronomicalSeasons))
+	assert.Equal(t, Autumn, d.Season(TraditionalSeasons))
+
+	// agrees with Seasons at the exact start
+	for _, definition := range []SeasonDefinition{AstronomicalSeasons, TraditionalSeasons} {
+		for _, s := range Seasons(2024, definition) {
+			assert.Equal(t, s.Season, FromSolarTime(s.Start.Add(time.Minute)).Season(definition))
+			assert.Equal(t, (s.Season+3)%4, FromSolarTime(s.Start.Add(-time.Minute)).Season(definition))
+		}
+	}
+}
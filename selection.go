@@ -0,0 +1,173 @@
+package vncalendar
+
+import (
+	"sort"
+	"time"
+)
+
+// Criterion is used to select dates (chọn ngày).
+// Evaluate reports whether the date is acceptable and its score,
+// higher score is better
+type Criterion interface {
+	Evaluate(d VNDate) (ok bool, score float64)
+}
+
+// Predicate is a criterion that only accepts or rejects a date
+type Predicate func(d VNDate) bool
+
+func (p Predicate) Evaluate(d VNDate) (bool, float64) {
+	return p(d), 0
+}
+
+// Scorer is a criterion that accepts every date and ranks it
+type Scorer func(d VNDate) float64
+
+func (s Scorer) Evaluate(d VNDate) (bool, float64) {
+	return true, s(d)
+}
+
+// Candidate is a selected date with its total score
+type Candidate struct {
+	Date  VNDate
+	Score float64
+}
+
+// SelectDates evaluates criteria on every date between fromDate and toDate,
+// including both, and returns accepted dates sorted by score, highest first.
+// Dates with the same score keep chronological order.
+// Criteria are evaluated in the given order and stop at the first rejection
+// so cheap predicates should come first
+func SelectDates(fromDate, toDate VNDate, criteria ...Criterion) []Candidate {
+	var candidates []Candidate
+	eachDate(fromDate, toDate, func(d VNDate) {
+		total := 0.0
+		for _, c := range criteria {
+			ok, score := c.Evaluate(d)
+			if !ok {
+				return
+			}
+			total += score
+		}
+		candidates = append(candidates, Candidate{Date: d, Score: total})
+	})
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates
+}
+
+// eachDate calls fn for the same dates as GetDatesBetween
+// but only does a full conversion near the end of lunar months
+func eachDate(fromDate, toDate VNDate, fn func(d VNDate)) {
+	d := fromDate
+	for {
+		fn(d)
+		if !d.Before(toDate) {
+			return
+		}
+		if d.lunarDate.Day < 29 {
+			// every lunar month has at least 29 days
+			next := d
+			next.solarTime = d.solarTime.AddDate(0, 0, 1)
+			next.lunarDate.Day++
+			d = next
+		} else {
+			d = d.NextDay()
+		}
+	}
+}
+
+// And accepts a date when all predicates accept it
+func And(predicates ...Predicate) Predicate {
+	return func(d VNDate) bool {
+		for _, p := range predicates {
+			if !p(d) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or accepts a date when any of predicates accepts it
+func Or(predicates ...Predicate) Predicate {
+	return func(d VNDate) bool {
+		for _, p := range predicates {
+			if p(d) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not accepts a date when p rejects it
+func Not(p Predicate) Predicate {
+	return func(d VNDate) bool {
+		return !p(d)
+	}
+}
+
+// Weight multiplies score of s by w
+func Weight(w float64, s Scorer) Scorer {
+	return func(d VNDate) float64 {
+		return w * s(d)
+	}
+}
+
+// Bonus scores w when p accepts the date and 0 otherwise
+func Bonus(w float64, p Predicate) Scorer {
+	return func(d VNDate) float64 {
+		if p(d) {
+			return w
+		}
+		return 0
+	}
+}
+
+// OnWeekdays accepts dates falling on any of given weekdays
+func OnWeekdays(days ...time.Weekday) Predicate {
+	return func(d VNDate) bool {
+		wd := d.solarTime.Weekday()
+		for _, day := range days {
+			if wd == day {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Weekend accepts Saturdays and Sundays
+func Weekend() Predicate {
+	return OnWeekdays(time.Saturday, time.Sunday)
+}
+
+// OnLunarDays accepts dates with any of given lunar days
+func OnLunarDays(days ...int) Predicate {
+	return func(d VNDate) bool {
+		for _, day := range days {
+			if d.Day() == day {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// HoangDao accepts auspicious days (ngày hoàng đạo)
+func HoangDao() Predicate {
+	return VNDate.IsHoangDao
+}
+
+// NotTamNuong rejects ngày Tam Nương
+func NotTamNuong() Predicate {
+	return Not(VNDate.IsTamNuong)
+}
+
+// NotClashingWith rejects days clashing with the given birth lunar year
+func NotClashingWith(birthYear int) Predicate {
+	return func(d VNDate) bool {
+		return !d.ClashesWithYear(birthYear)
+	}
+}
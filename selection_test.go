@@ -0,0 +1,62 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEachDate(t *testing.T) {
+	fromDate := Date(2024, time.January, 1, 12, 0, 0, 0)
+	toDate := Date(2024, time.December, 31, 12, 0, 0, 0)
+	expected := GetDatesBetween(fromDate, toDate)
+	var dates []VNDate
+	eachDate(fromDate, toDate, func(d VNDate) {
+		dates = append(dates, d)
+	})
+	assert.Equal(t, len(expected), len(dates))
+	for i := range expected {
+		assert.Equal(t, expected[i].LunarDate(), dates[i].LunarDate())
+		assert.True(t, expected[i].Equal(dates[i]))
+	}
+}
+
+func TestSelectDates(t *testing.T) {
+	fromDate := Date(2024, time.February, 1, 12, 0, 0, 0)
+	toDate := Date(2024, time.April, 30, 12, 0, 0, 0)
+	candidates := SelectDates(fromDate, toDate,
+		Weekend(),
+		HoangDao(),
+		NotClashingWith(1990),
+		NotTamNuong(),
+	)
+	assert.NotEmpty(t, candidates)
+	for i, c := range candidates {
+		wd := c.Date.SolarTime().Weekday()
+		assert.True(t, wd == time.Saturday || wd == time.Sunday)
+		assert.True(t, c.Date.IsHoangDao())
+		assert.False(t, c.Date.ClashesWithYear(1990))
+		assert.False(t, c.Date.IsTamNuong())
+		if i > 0 {
+			assert.True(t, candidates[i-1].Date.Before(c.Date))
+		}
+	}
+}
+
+func TestSelectDatesScore(t *testing.T) {
+	fromDate := Date(2024, time.February, 1, 12, 0, 0, 0)
+	toDate := Date(2024, time.February, 29, 12, 0, 0, 0)
+	candidates := SelectDates(fromDate, toDate,
+		Or(OnLunarDays(1), OnLunarDays(15)),
+		Bonus(2, HoangDao()),
+		Weight(0.5, Bonus(1, Weekend())),
+	)
+	// mùng 1 and rằm tháng Giêng Giáp Thìn
+	assert.Equal(t, 2, len(candidates))
+	// both are Saturdays, mùng 1 is hoàng đạo and rằm is Bạch Hổ
+	assert.Equal(t, 1, candidates[0].Date.Day())
+	assert.Equal(t, 2.5, candidates[0].Score)
+	assert.Equal(t, 15, candidates[1].Date.Day())
+	assert.Equal(t, 0.5, candidates[1].Score)
+}
@@ -0,0 +1,106 @@
+package vncalendar
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// SolarTerm is one of the 24 solar terms (tiết khí),
+// the value times 15 is the sun longitude in degrees when the term starts
+type SolarTerm int
+
+const (
+	XuanPhan SolarTerm = iota
+	ThanhMinh
+	CocVu
+	LapHa
+	TieuMan
+	MangChung
+	HaChi
+	TieuThu
+	DaiThu
+	LapThu
+	XuThu
+	BachLo
+	ThuPhan
+	HanLo
+	SuongGiang
+	LapDong
+	TieuTuyet
+	DaiTuyet
+	DongChi
+	TieuHan
+	DaiHan
+	LapXuan
+	VuThuy
+	KinhTrap
+)
+
+var solarTermNames = []string{
+	"Xuân phân", "Thanh minh", "Cốc vũ", "Lập hạ", "Tiểu mãn", "Mang chủng",
+	"Hạ chí", "Tiểu thử", "Đại thử", "Lập thu", "Xử thử", "Bạch lộ",
+	"Thu phân", "Hàn lộ", "Sương giáng", "Lập đông", "Tiểu tuyết", "Đại tuyết",
+	"Đông chí", "Tiểu hàn", "Đại hàn", "Lập xuân", "Vũ thủy", "Kinh trập",
+}
+
+func (s SolarTerm) String() string {
+	return solarTermNames[mod(int(s), 24)]
+}
+
+// Longitude returns the sun longitude in degrees starting the term
+func (s SolarTerm) Longitude() float64 {
+	return float64(mod(int(s), 24)) * 15
+}
+
+type SolarTermEvent struct {
+	Term SolarTerm
+	// Time is the exact start of the term in Vietnam time zone
+	Time time.Time
+}
+
+// SolarTerms returns the 24 solar terms starting in the given solar year,
+// from Tiểu hàn in January to Đông chí in December
+func SolarTerms(year int) []SolarTermEvent {
+	return solarTerms(defaultAstronomy(), year, VietNamTimeZone)
+}
+
+func solarTerms(a astronomy, year int, loc *time.Location) []SolarTermEvent {
+	events := make([]SolarTermEvent, 0, 24)
+	for i := range 24 {
+		term := SolarTerm(mod(int(TieuHan)+i, 24))
+		events = append(events, SolarTermEvent{Term: term, Time: solarTermTime(a, year, term, loc)})
+	}
+	return events
+}
+
+// SolarTerms returns the 24 solar terms starting in the date's solar year
+// with the settings of its calendar, in the date's time zone
+func (t VNDate) SolarTerms() []SolarTermEvent {
+	return solarTerms(t.astronomy(), t.solarTime.Year(), t.solarTime.Location())
+}
+
+// SolarTerm returns the solar term the date's time is in
+func (t VNDate) SolarTerm() SolarTerm {
+	return SolarTerm(int(t.astronomy().apparentSunLongitude(astro.JDFromTime(t.solarTime)) / 15))
+}
+
+// solarTermJD returns the julian day when the apparent sun longitude
+// reaches the given degrees in the solar year
+func solarTermJD(a astronomy, year int, longitude float64) float64 {
+	const tropicalYear = 365.2422
+	// around vernal equinox on 21st March
+	jd := float64(ProlepticGregorian.JDN(year, 3, 21)) + math.Mod(longitude+360, 360)/360*tropicalYear
+	if jd >= float64(ProlepticGregorian.JDN(year+1, 1, 1)) {
+		jd -= tropicalYear
+	}
+	for range 20 {
+		diff := math.Mod(longitude-a.apparentSunLongitude(jd)+540, 360) - 180
+		jd += diff / 360 * tropicalYear
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return jd
+}
@@ -0,0 +1,53 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar/deltat"
+)
+
+func TestSolarTerms(t *testing.T) {
+	terms := SolarTerms(2024)
+	assert.Equal(t, 24, len(terms))
+	assert.Equal(t, TieuHan, terms[0].Term)
+	assert.Equal(t, DongChi, terms[23].Term)
+	for i := 1; i < len(terms); i++ {
+		assert.True(t, terms[i-1].Time.Before(terms[i].Time))
+	}
+
+	expected := map[SolarTerm]time.Time{
+		TieuHan:  time.Date(2024, time.January, 5, 20, 49, 0, 0, time.UTC),
+		LapXuan:  time.Date(2024, time.February, 4, 8, 27, 0, 0, time.UTC),
+		XuanPhan: time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC),
+		HaChi:    time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC),
+		ThuPhan:  time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC),
+		DongChi:  time.Date(2024, time.December, 21, 9, 21, 0, 0, time.UTC),
+	}
+	for _, e := range terms {
+		if want, ok := expected[e.Term]; ok {
+			assert.WithinDuration(t, want, e.Time, 10*time.Minute, e.Term.String())
+		}
+		assert.Equal(t, VietNamTimeZone, e.Time.Location())
+	}
+}
+
+func TestVNDateSolarTerms(t *testing.T) {
+	assert.Equal(t, SolarTerms(2024), FromSolarTime(time.Date(2024, time.July, 1, 0, 0, 0, 0, VietNamTimeZone)).SolarTerms())
+
+	table := deltat.NewTable([]deltat.Entry{{Year: 2024, Seconds: deltat.EspenakMeeus.Seconds(2024) + 3600}})
+	c := NewCalendar(WithDeltaT(table), WithLocation(time.UTC))
+	terms := c.FromSolarTime(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)).SolarTerms()
+	assert.Equal(t, c.SolarTerms(2024), terms)
+	assert.Equal(t, time.UTC, terms[0].Time.Location())
+}
+
+func TestVNDateSolarTerm(t *testing.T) {
+	d := FromSolarTime(time.Date(2024, time.February, 4, 15, 0, 0, 0, VietNamTimeZone))
+	assert.Equal(t, DaiHan, d.SolarTerm())
+	d = d.Add(time.Hour)
+	assert.Equal(t, LapXuan, d.SolarTerm())
+	assert.Equal(t, "Lập xuân", d.SolarTerm().String())
+	assert.Equal(t, 315.0, d.SolarTerm().Longitude())
+}
@@ -0,0 +1,115 @@
+// Package sun computes sunrise, sunset, solar noon and twilight
+// of a VNDate at a place, in the time zone of the date
+package sun
+
+import (
+	"math"
+	"time"
+
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/internal/astro"
+	"github.com/vanng822/vncalendar/place"
+)
+
+// Altitudes of the sun's center in degrees defining the events
+const (
+	// SunriseAltitude accounts for refraction and the sun's radius
+	SunriseAltitude      = -0.8333
+	CivilAltitude        = -6.0
+	NauticalAltitude     = -12.0
+	AstronomicalAltitude = -18.0
+)
+
+// Events of the sun on a day. An event that does not happen, e.g.
+// astronomical dusk in summer far from the equator, is zero time
+type Events struct {
+	AstronomicalDawn time.Time
+	NauticalDawn     time.Time
+	CivilDawn        time.Time
+	Sunrise          time.Time
+	// Noon is the solar noon when the sun crosses the meridian
+	Noon             time.Time
+	Sunset           time.Time
+	CivilDusk        time.Time
+	NauticalDusk     time.Time
+	AstronomicalDusk time.Time
+}
+
+// DayLength returns the duration from sunrise to sunset
+func (e Events) DayLength() time.Duration {
+	if e.Sunrise.IsZero() || e.Sunset.IsZero() {
+		return 0
+	}
+	return e.Sunset.Sub(e.Sunrise)
+}
+
+// On returns the events on the solar date of d at the place,
+// in the location of d's solar time, with the ΔT model of d's calendar
+func On(d vncalendar.VNDate, p place.Place) Events {
+	t := d.SolarTime()
+	loc := t.Location()
+	model := d.DeltaT()
+	// the mean solar noon at the place, 12:00 UT less the longitude
+	utNoon := time.Date(t.Year(), t.Month(), t.Day(), 12, 0, 0, 0, time.UTC)
+	noon := transit(float64(vncalendar.JulianDayOf(utNoon))-p.Longitude/360, p, model)
+
+	toTime := func(jd float64, ok bool) time.Time {
+		if !ok {
+			return time.Time{}
+		}
+		return vncalendar.JulianDay(jd).Time().In(loc)
+	}
+	events := Events{Noon: toTime(noon, true)}
+	events.AstronomicalDawn = toTime(crossing(noon, p, model, AstronomicalAltitude, true))
+	events.NauticalDawn = toTime(crossing(noon, p, model, NauticalAltitude, true))
+	events.CivilDawn = toTime(crossing(noon, p, model, CivilAltitude, true))
+	events.Sunrise = toTime(crossing(noon, p, model, SunriseAltitude, true))
+	events.Sunset = toTime(crossing(noon, p, model, SunriseAltitude, false))
+	events.CivilDusk = toTime(crossing(noon, p, model, CivilAltitude, false))
+	events.NauticalDusk = toTime(crossing(noon, p, model, NauticalAltitude, false))
+	events.AstronomicalDusk = toTime(crossing(noon, p, model, AstronomicalAltitude, false))
+	return events
+}
+
+// siderealRate is the sidereal degrees per solar day
+const siderealRate = 360.985647
+
+// position returns local hour angle in (-180, 180] and declination
+// of the sun in degrees at the julian day in UT, the model converts it
+// to the terrestrial time of the series
+func position(jd float64, p place.Place, model deltat.Model) (hourAngle, dec float64) {
+	ra, dec := astro.SunEquatorial(jd + deltat.Days(model, jd))
+	hourAngle = astro.NormalizeDegree(astro.SiderealTime(jd)+p.Longitude-ra+180) - 180
+	return hourAngle, dec
+}
+
+// transit returns julian day of the solar noon near jd
+func transit(jd float64, p place.Place, model deltat.Model) float64 {
+	for range 3 {
+		hourAngle, _ := position(jd, p, model)
+		jd -= hourAngle / siderealRate
+	}
+	return jd
+}
+
+// crossing returns julian day when the sun crosses the altitude
+// before or after the noon, false if it stays above or below
+func crossing(noon float64, p place.Place, model deltat.Model, altitude float64, rising bool) (float64, bool) {
+	jd := noon
+	sinLat, cosLat := math.Sincos(p.Latitude * astro.Degree)
+	for range 5 {
+		hourAngle, dec := position(jd, p, model)
+		sinDec, cosDec := math.Sincos(dec * astro.Degree)
+		cosH := (math.Sin(altitude*astro.Degree) - sinLat*sinDec) / (cosLat * cosDec)
+		if cosH < -1 || cosH > 1 {
+			return 0, false
+		}
+		target := math.Acos(cosH) / astro.Degree
+		if rising {
+			target = -target
+		}
+		jd += (target - hourAngle) / siderealRate
+	}
+	return jd, true
+}
@@ -0,0 +1,85 @@
+package sun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vanng822/vncalendar"
+	"github.com/vanng822/vncalendar/deltat"
+	"github.com/vanng822/vncalendar/place"
+)
+
+func TestOn(t *testing.T) {
+	d := vncalendar.FromSolarTime(time.Date(2024, time.June, 21, 12, 0, 0, 0, vncalendar.VietNamTimeZone))
+	e := On(d, place.HaNoi)
+	// half day arc of 100.58° around the noon, equation of time -1.7 minutes
+	assert.WithinDuration(t, time.Date(2024, time.June, 21, 11, 58, 26, 0, vncalendar.VietNamTimeZone), e.Noon, time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.June, 21, 5, 16, 6, 0, vncalendar.VietNamTimeZone), e.Sunrise, time.Minute)
+	assert.WithinDuration(t, time.Date(2024, time.June, 21, 18, 40, 49, 0, vncalendar.VietNamTimeZone), e.Sunset, time.Minute)
+	assert.InDelta(t, 13.41, e.DayLength().Hours(), 0.02)
+	assert.Equal(t, vncalendar.VietNamTimeZone, e.Sunrise.Location())
+
+	order := []time.Time{
+		e.AstronomicalDawn, e.NauticalDawn, e.CivilDawn, e.Sunrise, e.Noon,
+		e.Sunset, e.CivilDusk, e.NauticalDusk, e.AstronomicalDusk,
+	}
+	for i := 1; i < len(order); i++ {
+		assert.True(t, order[i-1].Before(order[i]), "event %d", i)
+	}
+	// noon is symmetric between dawn and dusk within seconds
+	assert.InDelta(t, 0, (e.Noon.Sub(e.Sunrise) - e.Sunset.Sub(e.Noon)).Seconds(), 5)
+
+	winter := On(vncalendar.FromSolarTime(time.Date(2024, time.December, 21, 12, 0, 0, 0, vncalendar.VietNamTimeZone)), place.HaNoi)
+	assert.InDelta(t, 10.86, winter.DayLength().Hours(), 0.02)
+	// near the equator days change little
+	south := On(d, place.CanTho)
+	assert.Less(t, south.DayLength(), e.DayLength())
+	assert.Greater(t, south.DayLength(), 12*time.Hour)
+}
+
+func TestOnPolar(t *testing.T) {
+	c := vncalendar.NewCalendar(vncalendar.WithLocation(time.UTC))
+	tromso := place.Place{Name: "Tromsø", Latitude: 69.65, Longitude: 18.96}
+	e := On(c.FromSolarTime(time.Date(2024, time.June, 21, 12, 0, 0, 0, time.UTC)), tromso)
+	assert.True(t, e.Sunrise.IsZero())
+	assert.True(t, e.Sunset.IsZero())
+	assert.False(t, e.Noon.IsZero())
+	assert.Equal(t, time.Duration(0), e.DayLength())
+
+	e = On(c.FromSolarTime(time.Date(2024, time.December, 21, 12, 0, 0, 0, time.UTC)), tromso)
+	assert.True(t, e.Sunrise.IsZero())
+	assert.False(t, e.CivilDawn.IsZero())
+}
+
+func TestOnCalendarZone(t *testing.T) {
+	// the events are on the solar date of the date in its own time zone
+	c := vncalendar.NewCalendar(vncalendar.WithLocation(time.UTC))
+	e := On(c.FromSolarTime(time.Date(2024, time.June, 21, 1, 0, 0, 0, time.UTC)), place.HaNoi)
+	assert.Equal(t, time.UTC, e.Noon.Location())
+	assert.Equal(t, 21, e.Noon.Day())
+	assert.Equal(t, 20, e.Sunrise.Day())
+	assert.Equal(t, 22, e.Sunrise.Hour())
+}
+
+func TestOnDeltaT(t *testing.T) {
+	solarTime := time.Date(2024, time.June, 21, 12, 0, 0, 0, vncalendar.VietNamTimeZone)
+	e := On(vncalendar.FromSolarTime(solarTime), place.HaNoi)
+	// an hour more ΔT, the sun is an hour further on its orbit,
+	// about 0.04° in right ascension, so the noon is about 10 seconds later
+	table := deltat.NewTable([]deltat.Entry{{Year: 2024, Seconds: deltat.EspenakMeeus.Seconds(2024) + 3600}})
+	shifted := On(vncalendar.NewCalendar(vncalendar.WithDeltaT(table)).FromSolarTime(solarTime), place.HaNoi)
+	assert.InDelta(t, 10, shifted.Noon.Sub(e.Noon).Seconds(), 3)
+}
+
+func TestNoonGuess(t *testing.T) {
+	// 12:00 UT less the longitude is within the equation of time,
+	// at most 17 minutes, of the solar noon
+	for _, p := range []place.Place{place.HaNoi, place.HoChiMinhCity, {Name: "Greenwich", Latitude: 51.48}} {
+		for month := time.January; month <= time.December; month++ {
+			guess := float64(vncalendar.JulianDayOf(time.Date(2024, month, 1, 12, 0, 0, 0, time.UTC))) - p.Longitude/360
+			hourAngle, _ := position(guess, p, deltat.EspenakMeeus)
+			assert.InDelta(t, 0, hourAngle, 17.0/60*15, "%s %s", p.Name, month)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+#!/usr/bin/env python3
+"""Generate lunar_years.csv, the golden dataset of conformance_test.go.
+
+It is written in Python with the standard library only, directly from
+the rules of the Vietnamese calendar:
+
+- a month starts on the day (UTC+7) of the new moon
+- month 11 is the month containing the winter solstice
+- in a year with 13 months between two months 11, the first month
+  without a major term (trung khí) is the leap month and repeats the
+  number of the month before it
+
+New moons follow Meeus, Astronomical Algorithms, chapter 49, the sun
+follows chapter 25 with nutation and aberration and ΔT the polynomials
+of Espenak and Meeus.
+
+These are the algorithms of PrecisionEngine, so the dataset is not
+independent of the Go code. It catches porting and conversion bugs, not
+errors of the algorithms, and it has not been checked against a
+published table.
+
+Usage: python3 testdata/gen_lunar_years.py > testdata/lunar_years.csv
+"""
+
+import datetime
+import math
+
+TZ = 7 / 24
+FIRST_YEAR, LAST_YEAR = 1900, 2100
+
+
+def delta_t(jd):
+    y = 2000 + (jd - 2451545) / 365.25
+    if y < 1920:
+        t = y - 1900
+        return -2.79 + 1.494119*t - 0.0598939*t**2 + 0.0061966*t**3 - 0.000197*t**4
+    if y < 1941:
+        t = y - 1920
+        return 21.20 + 0.84493*t - 0.076100*t**2 + 0.0020936*t**3
+    if y < 1961:
+        t = y - 1950
+        return 29.07 + 0.407*t - t**2/233 + t**3/2547
+    if y < 1986:
+        t = y - 1975
+        return 45.45 + 1.067*t - t**2/260 - t**3/718
+    if y < 2005:
+        t = y - 2000
+        return (63.86 + 0.3345*t - 0.060374*t**2 + 0.0017275*t**3
+                + 0.000651814*t**4 + 0.00002373599*t**5)
+    if y < 2050:
+        t = y - 2000
+        return 62.92 + 0.32217*t + 0.005589*t**2
+    u = (y - 1820) / 100
+    if y < 2150:
+        return -20 + 32*u**2 - 0.5628*(2150 - y)
+    return -20 + 32*u**2
+
+
+def new_moon_jde(k):
+    s = math.sin
+    r = math.radians
+    t = k / 1236.85
+    jde = (2451550.09766 + 29.530588861*k + 0.00015437*t**2
+           - 0.000000150*t**3 + 0.00000000073*t**4)
+    e = 1 - 0.002516*t - 0.0000074*t**2
+    m = r(2.5534 + 29.10535670*k - 0.0000014*t**2 - 0.00000011*t**3)
+    mp = r(201.5643 + 385.81693528*k + 0.0107582*t**2 + 0.00001238*t**3 - 0.000000058*t**4)
+    f = r(160.7108 + 390.67050284*k - 0.0016118*t**2 - 0.00000227*t**3 + 0.000000011*t**4)
+    om = r(124.7746 - 1.56375588*k + 0.0020672*t**2 + 0.00000215*t**3)
+    jde += (-0.40720*s(mp) + 0.17241*e*s(m) + 0.01608*s(2*mp) + 0.01039*s(2*f)
+            + 0.00739*e*s(mp - m) - 0.00514*e*s(mp + m) + 0.00208*e*e*s(2*m)
+            - 0.00111*s(mp - 2*f) - 0.00057*s(mp + 2*f) + 0.00056*e*s(2*mp + m)
+            - 0.00042*s(3*mp) + 0.00042*e*s(m + 2*f) + 0.00038*e*s(m - 2*f)
+            - 0.00024*e*s(2*mp - m) - 0.00017*s(om) - 0.00007*s(mp + 2*m)
+            + 0.00004*s(2*mp - 2*f) + 0.00004*s(3*m) + 0.00003*s(mp + m - 2*f)
+            + 0.00003*s(2*mp + 2*f) - 0.00003*s(mp + m + 2*f) + 0.00003*s(mp - m + 2*f)
+            - 0.00002*s(mp - m - 2*f) - 0.00002*s(3*mp + m) + 0.00002*s(4*mp))
+    planetary = [
+        (0.000325, 299.77 + 0.107408*k - 0.009173*t**2),
+        (0.000165, 251.88 + 0.016321*k),
+        (0.000164, 251.83 + 26.651886*k),
+        (0.000126, 349.42 + 36.412478*k),
+        (0.000110, 84.66 + 18.206239*k),
+        (0.000062, 141.74 + 53.303771*k),
+        (0.000060, 207.14 + 2.453732*k),
+        (0.000056, 154.84 + 7.306860*k),
+        (0.000047, 34.52 + 27.261239*k),
+        (0.000042, 207.19 + 0.121824*k),
+        (0.000040, 291.34 + 1.844379*k),
+        (0.000037, 161.72 + 24.198154*k),
+        (0.000035, 239.56 + 25.513099*k),
+        (0.000023, 331.55 + 3.592518*k),
+    ]
+    jde += sum(a * s(r(arg)) for a, arg in planetary)
+    return jde
+
+
+def sun_longitude(jde):
+    """Apparent longitude of the sun in degrees, Meeus chapter 25."""
+    t = (jde - 2451545) / 36525
+    l0 = 280.46646 + 36000.76983*t + 0.0003032*t**2
+    m = math.radians(357.52911 + 35999.05029*t - 0.0001537*t**2)
+    c = ((1.914602 - 0.004817*t - 0.000014*t**2)*math.sin(m)
+         + (0.019993 - 0.000101*t)*math.sin(2*m) + 0.000289*math.sin(3*m))
+    om = math.radians(125.04 - 1934.136*t)
+    return (l0 + c - 0.00569 - 0.00478*math.sin(om)) % 360
+
+
+def major_term_jd(jd_from, degrees):
+    """First julian day in UT from jd_from when the sun reaches degrees."""
+    jd = jd_from + ((degrees - sun_longitude(jd_from)) % 360) / 360 * 365.2422
+    for _ in range(20):
+        diff = (degrees - sun_longitude(jd + delta_t(jd)/86400) + 540) % 360 - 180
+        jd += diff / 360 * 365.2422
+        if abs(diff) < 1e-9:
+            break
+    return jd
+
+
+def local_day(jd):
+    """Julian day number of the local date at julian day jd in UT."""
+    return math.floor(jd + 0.5 + TZ)
+
+
+def new_moon_day(k):
+    jde = new_moon_jde(k)
+    return local_day(jde - delta_t(jde)/86400)
+
+
+def jdn(d):
+    return d.toordinal() + 1721425
+
+
+def date_of(day):
+    return datetime.date.fromordinal(day - 1721425)
+
+
+def month11(year):
+    """First day of the month containing the winter solstice of the year."""
+    solstice = local_day(major_term_jd(jdn(datetime.date(year, 12, 1)), 270))
+    k = math.floor((solstice - 2451550.1) / 29.530588861)
+    while new_moon_day(k + 1) <= solstice:
+        k += 1
+    while new_moon_day(k) > solstice:
+        k -= 1
+    return k
+
+
+def has_major_term(k):
+    start, end = new_moon_day(k), new_moon_day(k + 1)
+    # sector of the sun at local midnight starting each day
+    before = int(sun_longitude_ut(start - 0.5 - TZ) // 30)
+    after = int(sun_longitude_ut(end - 0.5 - TZ) // 30)
+    return before != after
+
+
+def sun_longitude_ut(jd):
+    return sun_longitude(jd + delta_t(jd)/86400)
+
+
+def numbered_months():
+    """All months between the years as (year, number, leap, first day, length)."""
+    result = []
+    for y in range(FIRST_YEAR - 1, LAST_YEAR + 1):
+        a, b = month11(y), month11(y + 1)
+        leap_pending = b - a == 13
+        number, year = 10, y
+        for k in range(a, b):
+            leap = False
+            if k > a and leap_pending and not has_major_term(k):
+                leap, leap_pending = True, False
+            else:
+                number = number % 12 + 1
+                if number == 1:
+                    year = y + 1
+            start = new_moon_day(k)
+            result.append((year, number, leap, start, new_moon_day(k + 1) - start))
+    return result
+
+
+def main():
+    all_months = numbered_months()
+    print("year,tet,leap_month,month_lengths")
+    for year in range(FIRST_YEAR, LAST_YEAR + 1):
+        ms = [m for m in all_months if m[0] == year]
+        assert ms[0][1] == 1 and not ms[0][2] and len(ms) in (12, 13), (year, ms)
+        leap = next((n for _, n, l, _, _ in ms if l), 0)
+        lengths = " ".join(str(m[4]) for m in ms)
+        print("%d,%s,%d,%s" % (year, date_of(ms[0][3]).isoformat(), leap, lengths))
+
+
+if __name__ == "__main__":
+    main()
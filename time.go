@@ -3,6 +3,8 @@ package vncalendar
 import (
 	"fmt"
 	"time"
+
+	"github.com/vanng822/vncalendar/deltat"
 )
 
 var (
@@ -10,21 +12,47 @@ var (
 	VietNamTimeZone = time.FixedZone("ICT", 7*60*60)
 )
 
+// defaultOffset returns TimeZoneOffset as a duration
+func defaultOffset() time.Duration {
+	return time.Duration(TimeZoneOffset) * time.Hour
+}
+
 type VNDate struct {
-	solarTime      time.Time
-	lunarDate      LunarDate
-	timeZoneOffset int
+	solarTime time.Time
+	lunarDate LunarDate
+	// nil for dates created by package level functions
+	calendar *Calendar
 }
 
-func newVNDate(solarTime time.Time, timeZoneOffset int) VNDate {
-	t := VNDate{solarTime: solarTime, timeZoneOffset: timeZoneOffset}
-	t.lunarDate = Solar2lunar(t.solarTime.Year(), int(t.solarTime.Month()), t.solarTime.Day(), timeZoneOffset)
+func newVNDate(solarTime time.Time, calendar *Calendar) VNDate {
+	t := VNDate{solarTime: solarTime, calendar: calendar}
+	t.lunarDate = calendar.converter().lunarDate(t.JDN())
 
 	return t
 }
 
+func (t VNDate) astronomy() astronomy {
+	return t.calendar.astronomy()
+}
+
+// DeltaT returns the ΔT model of the date's calendar, see WithDeltaT.
+// The moon and sun packages take it to compute events of the date
+func (t VNDate) DeltaT() deltat.Model {
+	return t.calendar.getDeltaT()
+}
+
+// civilDate returns the solar date the lunar day is derived from,
+// which is the next day from the calendar's day start hour
+func (t VNDate) civilDate() (year int, month time.Month, day int) {
+	dayStartHour := t.calendar.getDayStartHour()
+	if dayStartHour > 0 && t.solarTime.Hour() >= dayStartHour {
+		return t.solarTime.AddDate(0, 0, 1).Date()
+	}
+	return t.solarTime.Date()
+}
+
 func Today() VNDate {
-	return newVNDate(time.Now().In(VietNamTimeZone), TimeZoneOffset)
+	return newVNDate(time.Now().In(VietNamTimeZone), nil)
 }
 
 func YesterDay() VNDate {
@@ -32,14 +60,40 @@ func YesterDay() VNDate {
 }
 
 // Date creates VNDate from given year, month, day, hour, min, sec, nsec in Vietnam time zone
-// Paremeters are the same as time.Date, that is "solar/Julian" date parameters
+// Paremeters are the same as time.Date, that is "solar/Julian" date parameters.
+//
+// Deprecated: Date does not check the supported range, use CheckedDate
 func Date(year int, month time.Month, day, hour, min, sec, nsec int) VNDate {
 	solarTime := time.Date(year, month, day, hour, min, sec, nsec, time.UTC).In(VietNamTimeZone)
-	return newVNDate(solarTime, TimeZoneOffset)
+	return newVNDate(solarTime, nil)
+}
+
+// CheckedDate works as Date and returns *RangeError
+// if the solar date in Vietnam is not supported
+func CheckedDate(year int, month time.Month, day, hour, min, sec, nsec int) (VNDate, error) {
+	return checkSolarTime(Date(year, month, day, hour, min, sec, nsec))
 }
 
+// FromSolarTime creates VNDate from t in Vietnam time zone.
+//
+// Deprecated: FromSolarTime does not check the supported range,
+// use CheckedFromSolarTime
 func FromSolarTime(t time.Time) VNDate {
-	return newVNDate(t.In(VietNamTimeZone), TimeZoneOffset)
+	return newVNDate(t.In(VietNamTimeZone), nil)
+}
+
+// FromSolarDay creates VNDate at noon in Vietnam on the solar date of t
+// in Vietnam, values depending on the time of the day such as SolarTerm
+// are then the same for any time of that day
+func FromSolarDay(t time.Time) VNDate {
+	year, month, day := t.In(VietNamTimeZone).Date()
+	return FromSolarTime(time.Date(year, month, day, 12, 0, 0, 0, VietNamTimeZone))
+}
+
+// CheckedFromSolarTime works as FromSolarTime and returns *RangeError
+// if the solar date in Vietnam is not supported
+func CheckedFromSolarTime(t time.Time) (VNDate, error) {
+	return checkSolarTime(FromSolarTime(t))
 }
 
 func (t VNDate) SolarTime() time.Time {
@@ -57,12 +111,12 @@ func (t VNDate) Sub(u VNDate) time.Duration {
 
 // Add returns the time t+d
 func (t VNDate) Add(d time.Duration) VNDate {
-	return newVNDate(t.solarTime.Add(d), t.timeZoneOffset)
+	return newVNDate(t.solarTime.Add(d), t.calendar)
 }
 
 // AddDate returns the time t+years, months, days
 func (t VNDate) AddDate(years int, months int, days int) VNDate {
-	return newVNDate(t.solarTime.AddDate(years, months, days), t.timeZoneOffset)
+	return newVNDate(t.solarTime.AddDate(years, months, days), t.calendar)
 }
 
 func (t VNDate) NextDay() VNDate {
@@ -87,15 +141,15 @@ func (t VNDate) Equal(u VNDate) bool {
 
 func (t VNDate) String() string {
 	return fmt.Sprintf("%s-%s-%s (%s-%s-%s)",
-		padd(t.Year()), padd(int(t.Month())), padd(t.Day()),
-		padd(t.solarTime.Year()), padd(int(t.solarTime.Month())), padd(t.solarTime.Day()))
+		paddYear(t.Year()), padd(int(t.Month())), padd(t.Day()),
+		paddYear(t.solarTime.Year()), padd(int(t.solarTime.Month())), padd(t.solarTime.Day()))
 }
 
 // Format using Sprintf where inputs are string with zero padd
 // First position is year, 2nd month, 3rth day
 // Default is %[1]s-%[2]s-%[3]s
 func (t VNDate) Format(layout string) string {
-	return t.format(layout, padd(t.Year()), padd(int(t.Month())), padd(t.Day()))
+	return t.format(layout, paddYear(t.Year()), padd(int(t.Month())), padd(t.Day()))
 }
 
 func (t VNDate) format(layout, year, month, day string) string {
@@ -111,7 +165,7 @@ func (t VNDate) FormatDisplay() string {
 
 func (t VNDate) FormatSolarDateDisplay() string {
 	layout := "%[3]s/%[2]s/%[1]s"
-	return t.format(layout, padd(t.solarTime.Year()), padd(int(t.solarTime.Month())), padd(t.solarTime.Day()))
+	return t.format(layout, paddYear(t.solarTime.Year()), padd(int(t.solarTime.Month())), padd(t.solarTime.Day()))
 }
 
 func (t VNDate) Day() int {
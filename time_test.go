@@ -15,6 +15,20 @@ func TestFromSolarTime(t *testing.T) {
 	assert.Equal(t, 2014, lunarTime.Year())
 }
 
+func TestFromSolarDay(t *testing.T) {
+	noon := time.Date(2025, time.January, 29, 12, 0, 0, 0, VietNamTimeZone)
+	for _, solarTime := range []time.Time{
+		time.Date(2025, time.January, 29, 0, 0, 0, 0, VietNamTimeZone),
+		time.Date(2025, time.January, 29, 23, 59, 0, 0, VietNamTimeZone),
+		// 29 January in Vietnam
+		time.Date(2025, time.January, 28, 20, 0, 0, 0, time.UTC),
+	} {
+		d := FromSolarDay(solarTime)
+		assert.Equal(t, noon, d.SolarTime())
+		assert.Equal(t, LunarDate{Year: 2025, Month: 1, Day: 1}, d.LunarDate())
+	}
+}
+
 func TestAdd(t *testing.T) {
 	l, _ := time.Parse("Jan 2, 2006 at 3:04pm", "Sep 16, 2014 at 3:04pm")
 	n := l.Add(time.Duration(24 * time.Hour))
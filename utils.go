@@ -8,3 +8,17 @@ func padd(digits int) string {
 	}
 	return fmt.Sprintf("0%d", digits)
 }
+
+// paddYear pads a year to 4 digits as in the "YYYY-MM-DD" of ParseDate
+func paddYear(year int) string {
+	return fmt.Sprintf("%04d", year)
+}
+
+// mod is modulo that always returns a non-negative value
+func mod(a, n int) int {
+	r := a % n
+	if r < 0 {
+		r += n
+	}
+	return r
+}
@@ -0,0 +1,92 @@
+package vncalendar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/vanng822/vncalendar/internal/astro"
+)
+
+// zone returns the UTC offset in fractional days at a julian day in UT
+type zone func(jd float64) float64
+
+func fixedZone(offset time.Duration) zone {
+	days := offset.Hours() / 24
+	return func(float64) float64 {
+		return days
+	}
+}
+
+func locationZone(loc *time.Location) zone {
+	return func(jd float64) float64 {
+		_, offset := astro.TimeFromJD(jd).In(loc).Zone()
+		return float64(offset) / 86400
+	}
+}
+
+// Historical time zones the lunar calendar was computed for, not civil
+// time, use them with WithCalculationZone. The North computed the calendar
+// for UTC+8 as the Chinese calendar until the decree of 8 August 1967
+// while its clocks were at UTC+7, the South kept UTC+8 until 1975.
+// This is why Tết Mậu Thân 1968 was on 29 January in Hanoi and on
+// 30 January in Saigon. With WithLocation they would also display times
+// before 1967 or 1975 at +08:00, which was not the time on the clocks
+var (
+	Hanoi = newLocation("Hanoi", []zoneTransition{
+		{offset: 8 * time.Hour},
+		{at: time.Date(1967, time.August, 8, 0, 0, 0, 0, time.FixedZone("+08", 8*60*60)), offset: 7 * time.Hour},
+	})
+	Saigon = newLocation("Saigon", []zoneTransition{
+		{offset: 8 * time.Hour},
+		{at: time.Date(1975, time.June, 13, 0, 0, 0, 0, time.FixedZone("+08", 8*60*60)), offset: 7 * time.Hour},
+	})
+)
+
+// zoneTransition starts a UTC offset at a time,
+// the first transition has zero time and applies before the others
+type zoneTransition struct {
+	at     time.Time
+	offset time.Duration
+}
+
+// newLocation builds a location with the transitions,
+// encoded as TZif data since time package has no other way to create one
+func newLocation(name string, transitions []zoneTransition) *time.Location {
+	var abbreviations []byte
+	var types, times, indexes bytes.Buffer
+	for i, t := range transitions {
+		seconds := int32(t.offset / time.Second)
+		binary.Write(&types, binary.BigEndian, seconds)
+		types.WriteByte(0) // not daylight saving time
+		types.WriteByte(byte(len(abbreviations)))
+		abbreviations = append(abbreviations, offsetName(t.offset)...)
+		abbreviations = append(abbreviations, 0)
+		if i > 0 {
+			binary.Write(&times, binary.BigEndian, int32(t.at.Unix()))
+			indexes.WriteByte(byte(i))
+		}
+	}
+
+	var data bytes.Buffer
+	data.WriteString("TZif")
+	data.Write(make([]byte, 16)) // version 1 and reserved
+	for _, count := range []int{0, 0, 0, len(transitions) - 1, len(transitions), len(abbreviations)} {
+		binary.Write(&data, binary.BigEndian, uint32(count))
+	}
+	data.Write(times.Bytes())
+	data.Write(indexes.Bytes())
+	data.Write(types.Bytes())
+	data.Write(abbreviations)
+
+	loc, err := time.LoadLocationFromTZData(name, data.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// offsetName returns the abbreviation as in tz database, e.g. +07
+func offsetName(offset time.Duration) string {
+	return time.Date(2000, 1, 1, 0, 0, 0, 0, time.FixedZone("", int(offset/time.Second))).Format("-07")
+}
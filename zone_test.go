@@ -0,0 +1,76 @@
+package vncalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoricalZones(t *testing.T) {
+	hanoi := NewCalendar(WithCalculationZone(Hanoi))
+	saigon := NewCalendar(WithCalculationZone(Saigon))
+	// Tết Mậu Thân
+	assert.Equal(t, SolarDate{Year: 1968, Month: 1, Day: 29}, hanoi.Lunar2solar(1968, 1, 1, false))
+	assert.Equal(t, SolarDate{Year: 1968, Month: 1, Day: 30}, saigon.Lunar2solar(1968, 1, 1, false))
+
+	d := saigon.FromSolarTime(time.Date(1968, time.January, 29, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, LunarDate{Year: 1967, Month: 12, Day: 30}, d.LunarDate())
+	// dates stay in Vietnam time
+	assert.Equal(t, 19, d.SolarTime().Hour())
+	d = hanoi.FromSolarTime(time.Date(1968, time.January, 29, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, LunarDate{Year: 1968, Month: 1, Day: 1}, d.LunarDate())
+	assert.Equal(t, 19, d.SolarTime().Hour())
+	d = hanoi.FromSolarTime(time.Date(1960, time.June, 1, 12, 0, 0, 0, time.UTC))
+	assert.Equal(t, VietNamTimeZone, d.SolarTime().Location())
+
+	// after 1975 both are the same as the default UTC+7
+	for _, year := range []int{1976, 2000, 2024} {
+		tet := Lunar2solar(year, 1, 1, false, TimeZoneOffset)
+		assert.Equal(t, tet, hanoi.Lunar2solar(year, 1, 1, false))
+		assert.Equal(t, tet, saigon.Lunar2solar(year, 1, 1, false))
+	}
+}
+
+func TestWithLocationEvaluatedAtEachInstant(t *testing.T) {
+	hanoi := NewCalendar(WithCalculationZone(Hanoi))
+	china := NewCalendar(WithLocation(time.FixedZone("+08", 8*60*60)))
+	vietnam := NewCalendar()
+	for _, date := range []SolarDate{{1960, 3, 1}, {1965, 7, 15}, {1967, 8, 7}} {
+		assert.Equal(t, china.Solar2lunar(date.Year, date.Month, date.Day), hanoi.Solar2lunar(date.Year, date.Month, date.Day))
+	}
+	for _, date := range []SolarDate{{1967, 8, 8}, {1967, 12, 31}, {1968, 1, 1}, {1985, 1, 21}, {2024, 2, 10}} {
+		assert.Equal(t, vietnam.Solar2lunar(date.Year, date.Month, date.Day), hanoi.Solar2lunar(date.Year, date.Month, date.Day))
+	}
+	assert.Equal(t, "+08", time.Date(1967, 8, 7, 0, 0, 0, 0, Hanoi).Format("MST"))
+	assert.Equal(t, "+07", time.Date(1967, 8, 8, 1, 0, 0, 0, Hanoi).Format("MST"))
+	assert.Equal(t, "+08", time.Date(1975, 6, 12, 0, 0, 0, 0, Saigon).Format("MST"))
+	assert.Equal(t, "+07", time.Date(1975, 6, 13, 12, 0, 0, 0, Saigon).Format("MST"))
+}
+
+func TestWithCalculationZone(t *testing.T) {
+	china := time.FixedZone("+08", 8*60*60)
+	// the calculation zone applies whatever the order of the options
+	for _, c := range []*Calendar{
+		NewCalendar(WithLocation(time.UTC), WithCalculationZone(china)),
+		NewCalendar(WithCalculationZone(china), WithLocation(time.UTC)),
+	} {
+		assert.Equal(t, SolarDate{Year: 1968, Month: 1, Day: 30}, c.Lunar2solar(1968, 1, 1, false))
+		assert.Equal(t, time.UTC, c.Today().SolarTime().Location())
+	}
+	// without it the conversion uses the location
+	assert.Equal(t, SolarDate{Year: 1968, Month: 1, Day: 30}, NewCalendar(WithLocation(china)).Lunar2solar(1968, 1, 1, false))
+}
+
+func TestWithLocationDefault(t *testing.T) {
+	// nil falls back to Vietnam time zone
+	c := NewCalendar(WithLocation(nil), WithCalculationZone(nil))
+	assert.Equal(t, VietNamTimeZone, c.Today().SolarTime().Location())
+	assert.Equal(t, Solar2lunar(1968, 1, 29, TimeZoneOffset), c.Solar2lunar(1968, 1, 29))
+
+	// another location at UTC+7 converts as the default
+	ict := NewCalendar(WithLocation(time.FixedZone("ICT", 7*60*60)))
+	for _, date := range []SolarDate{{1944, 6, 21}, {1968, 1, 29}, {2024, 2, 10}} {
+		assert.Equal(t, Solar2lunar(date.Year, date.Month, date.Day, TimeZoneOffset), ict.Solar2lunar(date.Year, date.Month, date.Day))
+	}
+}